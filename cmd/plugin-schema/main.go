@@ -0,0 +1,201 @@
+/*
+plugin-schema dumps the schema of a plugin to JSON, and compares schema dumps to report breaking changes.
+
+Usage:
+
+	plugin-schema dump [-name <plugin name>] [-config <connection config file>] <plugin binary> > schema.json
+	plugin-schema diff [-breaking-only] <old schema.json> <new schema.json>
+	plugin-schema config-schema [-name <plugin name>] <plugin binary> > connection.schema.json
+
+diff exits with status 1 if the new schema has any breaking changes.
+
+config-schema writes the plugin connection config schema as a JSON Schema document,
+which may be used by editors and linters to validate connection config.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	pluginshared "github.com/turbot/steampipe-plugin-sdk/v5/grpc/shared"
+	"github.com/turbot/steampipe-plugin-sdk/v5/logging"
+	"github.com/turbot/steampipe-plugin-sdk/v5/schema_diff"
+)
+
+// the name of the connection used to retrieve the schema
+const connectionName = "schema_dump"
+
+const usage = `usage:
+  plugin-schema dump [-name <plugin name>] [-config <connection config file>] <plugin binary>
+  plugin-schema diff [-breaking-only] <old schema.json> <new schema.json>
+  plugin-schema config-schema [-name <plugin name>] <plugin binary>
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "dump":
+		err = dump(os.Args[2:])
+	case "config-schema":
+		err = configSchema(os.Args[2:])
+	case "diff":
+		var breaking bool
+		breaking, err = diff(os.Args[2:], os.Stdout)
+		if err == nil && breaking {
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(2)
+	}
+}
+
+func dump(args []string) error {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	pluginName := flags.String("name", "", "the plugin name (defaults to the binary name, without extension)")
+	configPath := flags.String("config", "", "a file containing the HCL connection config to use")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("dump requires the path of the plugin binary")
+	}
+	var config string
+	if *configPath != "" {
+		data, err := os.ReadFile(*configPath)
+		if err != nil {
+			return err
+		}
+		config = string(data)
+	}
+
+	pluginClient, closeFunc, err := startPlugin(flags.Arg(0), *pluginName)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	schema, err := getSchema(pluginClient, config)
+	if err != nil {
+		return err
+	}
+	return schema_diff.WriteSchema(os.Stdout, schema)
+}
+
+func configSchema(args []string) error {
+	flags := flag.NewFlagSet("config-schema", flag.ExitOnError)
+	pluginName := flags.String("name", "", "the plugin name (defaults to the binary name, without extension)")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		return fmt.Errorf("config-schema requires the path of the plugin binary")
+	}
+
+	pluginClient, closeFunc, err := startPlugin(flags.Arg(0), *pluginName)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	supportedOperations, err := pluginClient.GetSupportedOperations()
+	if err != nil {
+		return err
+	}
+	if !supportedOperations.ConnectionConfigSchema {
+		return fmt.Errorf("plugin '%s' does not support GetConnectionConfigSchema - it must be rebuilt with a newer SDK version", pluginClient.Name)
+	}
+	jsonSchema, err := pluginClient.GetConnectionConfigSchema()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, jsonSchema)
+	return err
+}
+
+// startPlugin starts the plugin binary and returns a client for it, and a function to stop the plugin
+// if pluginName is not set, it defaults to the binary name, without extension
+func startPlugin(binaryPath, pluginName string) (*grpc.PluginClient, func(), error) {
+	if pluginName == "" {
+		base := filepath.Base(binaryPath)
+		pluginName = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	// discard logging from the client
+	logger := logging.NewLogger(&hclog.LoggerOptions{Name: "plugin", Output: io.Discard})
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  pluginshared.Handshake,
+		Plugins:          map[string]goplugin.Plugin{pluginName: &pluginshared.WrapperPlugin{}},
+		Cmd:              exec.Command(binaryPath),
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+		Logger:           logger,
+	})
+
+	pluginClient, err := grpc.NewPluginClient(client, pluginName)
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed to start plugin '%s': %s", binaryPath, err.Error())
+	}
+	return pluginClient, client.Kill, nil
+}
+
+// getSchema sets the connection config and retrieves the schema
+func getSchema(pluginClient *grpc.PluginClient, config string) (*proto.Schema, error) {
+	req := &proto.SetAllConnectionConfigsRequest{
+		Configs: []*proto.ConnectionConfig{{
+			Connection: connectionName,
+			Plugin:     pluginClient.Name,
+			Config:     config,
+		}},
+		// do not create a query cache
+		MaxCacheSizeMb: -1,
+	}
+	resp, err := pluginClient.SetAllConnectionConfigs(req)
+	if err != nil {
+		return nil, err
+	}
+	if failure, ok := resp.GetFailedConnections()[connectionName]; ok {
+		return nil, fmt.Errorf("failed to set connection config: %s", failure)
+	}
+	return pluginClient.GetSchema(connectionName)
+}
+
+// diff compares two schema dumps and writes the report
+// it returns whether there are breaking changes
+func diff(args []string, w io.Writer) (bool, error) {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	breakingOnly := flags.Bool("breaking-only", false, "only report breaking changes")
+	flags.Parse(args)
+	if flags.NArg() != 2 {
+		return false, fmt.Errorf("diff requires the paths of the old and new schema files")
+	}
+	oldSchema, err := schema_diff.ReadSchema(flags.Arg(0))
+	if err != nil {
+		return false, err
+	}
+	newSchema, err := schema_diff.ReadSchema(flags.Arg(1))
+	if err != nil {
+		return false, err
+	}
+
+	report := schema_diff.Diff(oldSchema, newSchema)
+	if *breakingOnly {
+		report = &schema_diff.Report{Changes: report.BreakingChanges()}
+	}
+	fmt.Fprintln(w, report.String())
+	return report.HasBreakingChanges(), nil
+}
@@ -11,14 +11,29 @@ import (
 	"time"
 )
 
+// DefaultConnectionCacheTTL is the TTL used by Set if no default TTL is specified
+const DefaultConnectionCacheTTL = 1 * time.Hour
+
 // ConnectionCache is a simple cache wrapper - multiple connections use the same underlying cache (owned by the plugin)
 // ConnectionCache modifies the cache keys to include the connection name and uses the underlying shared cache
 type ConnectionCache struct {
 	connectionName string
 	cache          *cache.Cache[any]
+	ristrettoCache *ristretto.Cache
+	// the TTL used by Set
+	defaultTTL time.Duration
 }
 
 func NewConnectionCache(connectionName string, maxCost int64) (*ConnectionCache, error) {
+	return NewConnectionCacheWithTTL(connectionName, maxCost, DefaultConnectionCacheTTL)
+}
+
+// NewConnectionCacheWithTTL creates a ConnectionCache whose Set function uses the given TTL
+// (if defaultTTL is zero, DefaultConnectionCacheTTL is used)
+func NewConnectionCacheWithTTL(connectionName string, maxCost int64, defaultTTL time.Duration) (*ConnectionCache, error) {
+	if defaultTTL == 0 {
+		defaultTTL = DefaultConnectionCacheTTL
+	}
 
 	ristrettoCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,
@@ -34,6 +49,8 @@ func NewConnectionCache(connectionName string, maxCost int64) (*ConnectionCache,
 	cache := &ConnectionCache{
 		connectionName: connectionName,
 		cache:          connectionCacheStore,
+		ristrettoCache: ristrettoCache,
+		defaultTTL:     defaultTTL,
 	}
 
 	log.Printf("[INFO] Created connection cache for connection '%s'", connectionName)
@@ -42,7 +59,7 @@ func NewConnectionCache(connectionName string, maxCost int64) (*ConnectionCache,
 }
 
 func (c *ConnectionCache) Set(ctx context.Context, key string, value interface{}) error {
-	return c.SetWithTTL(ctx, key, value, 1*time.Hour)
+	return c.SetWithTTL(ctx, key, value, c.defaultTTL)
 }
 
 func (c *ConnectionCache) SetWithTTL(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
@@ -57,7 +74,7 @@ func (c *ConnectionCache) SetWithTTL(ctx context.Context, key string, value inte
 	)
 
 	// wait for value to pass through buffers (necessary for ristretto)
-	time.Sleep(10 * time.Millisecond)
+	c.ristrettoCache.Wait()
 
 	if err != nil {
 		log.Printf("[WARN] SetWithTTL (connection %s, cache key %s) failed - error %v", c.connectionName, key, err)
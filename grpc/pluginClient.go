@@ -5,6 +5,7 @@ import (
 	pluginshared "github.com/turbot/steampipe-plugin-sdk/v5/grpc/shared"
 	"io/ioutil"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
@@ -138,6 +139,26 @@ func (c *PluginClient) GetRateLimiters(req *proto.GetRateLimitersRequest) (*prot
 	return resp, nil
 }
 
+// GetConnectionConfigSchema returns the plugin connection config schema, as a JSON Schema document
+func (c *PluginClient) GetConnectionConfigSchema() (string, error) {
+	resp, err := c.Stub.GetConnectionConfigSchema(&proto.GetConnectionConfigSchemaRequest{})
+	if err != nil {
+		return "", HandleGrpcError(err, c.Name, "GetConnectionConfigSchema")
+	}
+	return resp.JsonSchema, nil
+}
+
+// TestConnection runs the plugin connection check for the given connections (or all connections if none are given)
+// and returns the results, keyed by connection name.
+// If timeout is zero, the plugin default timeout is used.
+func (c *PluginClient) TestConnection(connections []string, timeout time.Duration) (map[string]*proto.ConnectionCheckResult, error) {
+	resp, err := c.Stub.TestConnection(&proto.TestConnectionRequest{Connections: connections, TimeoutMs: timeout.Milliseconds()})
+	if err != nil {
+		return nil, HandleGrpcError(err, c.Name, "TestConnection")
+	}
+	return resp.Results, nil
+}
+
 func (c *PluginClient) GetSchema(connectionName string) (*proto.Schema, error) {
 	resp, err := c.Stub.GetSchema(&proto.GetSchemaRequest{Connection: connectionName})
 	if err != nil {
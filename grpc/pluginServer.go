@@ -1,7 +1,9 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/turbot/go-kit/helpers"
@@ -23,6 +25,8 @@ type SetRateLimitersFunc func(*proto.SetRateLimitersRequest) error
 type GetRateLimitersFunc func() []*proto.RateLimiterDefinition
 type EstablishMessageStreamFunc func(stream proto.WrapperPlugin_EstablishMessageStreamServer) error
 type GetSchemaModeFunc func() string
+type GetConnectionConfigSchemaFunc func() (string, error)
+type TestConnectionFunc func(ctx context.Context, connections []string, timeout time.Duration) map[string]*proto.ConnectionCheckResult
 
 // PluginServer is the server for a single plugin
 type PluginServer struct {
@@ -39,6 +43,8 @@ type PluginServer struct {
 	setRateLimitersFunc           SetRateLimitersFunc
 	getRateLimitersFunc           GetRateLimitersFunc
 	getSchemaModeFunc             GetSchemaModeFunc
+	getConnectionConfigSchemaFunc GetConnectionConfigSchemaFunc
+	testConnectionFunc            TestConnectionFunc
 }
 
 func NewPluginServer(pluginName string,
@@ -53,6 +59,8 @@ func NewPluginServer(pluginName string,
 	getRateLimitersFunc GetRateLimitersFunc,
 	setConnectionCacheOptionsFunc SetConnectionCacheOptionsFunc,
 	GetSchemaModeFunc GetSchemaModeFunc,
+	getConnectionConfigSchemaFunc GetConnectionConfigSchemaFunc,
+	testConnectionFunc TestConnectionFunc,
 ) *PluginServer {
 
 	return &PluginServer{
@@ -68,6 +76,8 @@ func NewPluginServer(pluginName string,
 		getRateLimitersFunc:           getRateLimitersFunc,
 		setConnectionCacheOptionsFunc: setConnectionCacheOptionsFunc,
 		getSchemaModeFunc:             GetSchemaModeFunc,
+		getConnectionConfigSchemaFunc: getConnectionConfigSchemaFunc,
+		testConnectionFunc:            testConnectionFunc,
 	}
 }
 
@@ -180,11 +190,14 @@ func (s PluginServer) UpdateConnectionConfigs(req *proto.UpdateConnectionConfigs
 
 func (s PluginServer) GetSupportedOperations(*proto.GetSupportedOperationsRequest) (*proto.GetSupportedOperationsResponse, error) {
 	return &proto.GetSupportedOperationsResponse{
-		QueryCache:          true,
-		MultipleConnections: true,
-		MessageStream:       true,
-		SetCacheOptions:     true,
-		RateLimiters:        true,
+		QueryCache:             true,
+		MultipleConnections:    true,
+		MessageStream:          true,
+		SetCacheOptions:        true,
+		RateLimiters:           true,
+		HydrateStats:           true,
+		ConnectionConfigSchema: true,
+		TestConnection:         true,
 	}, nil
 }
 
@@ -208,6 +221,19 @@ func (s PluginServer) GetRateLimiters(*proto.GetRateLimitersRequest) (*proto.Get
 	return &proto.GetRateLimitersResponse{Definitions: rateLimiters}, nil
 }
 
+func (s PluginServer) GetConnectionConfigSchema(*proto.GetConnectionConfigSchemaRequest) (*proto.GetConnectionConfigSchemaResponse, error) {
+	jsonSchema, err := s.getConnectionConfigSchemaFunc()
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetConnectionConfigSchemaResponse{JsonSchema: jsonSchema}, nil
+}
+
+func (s PluginServer) TestConnection(ctx context.Context, req *proto.TestConnectionRequest) (*proto.TestConnectionResponse, error) {
+	timeout := time.Duration(req.TimeoutMs) * time.Millisecond
+	return &proto.TestConnectionResponse{Results: s.testConnectionFunc(ctx, req.Connections, timeout)}, nil
+}
+
 func (s PluginServer) EstablishMessageStream(stream proto.WrapperPlugin_EstablishMessageStreamServer) error {
 	return s.establishMessageStreamFunc(stream)
 }
@@ -30,6 +30,8 @@ const (
 	WrapperPlugin_SetRateLimiters_FullMethodName           = "/proto.WrapperPlugin/SetRateLimiters"
 	WrapperPlugin_GetRateLimiters_FullMethodName           = "/proto.WrapperPlugin/GetRateLimiters"
 	WrapperPlugin_SetConnectionCacheOptions_FullMethodName = "/proto.WrapperPlugin/SetConnectionCacheOptions"
+	WrapperPlugin_GetConnectionConfigSchema_FullMethodName = "/proto.WrapperPlugin/GetConnectionConfigSchema"
+	WrapperPlugin_TestConnection_FullMethodName            = "/proto.WrapperPlugin/TestConnection"
 )
 
 // WrapperPluginClient is the client API for WrapperPlugin service.
@@ -47,6 +49,8 @@ type WrapperPluginClient interface {
 	SetRateLimiters(ctx context.Context, in *SetRateLimitersRequest, opts ...grpc.CallOption) (*SetRateLimitersResponse, error)
 	GetRateLimiters(ctx context.Context, in *GetRateLimitersRequest, opts ...grpc.CallOption) (*GetRateLimitersResponse, error)
 	SetConnectionCacheOptions(ctx context.Context, in *SetConnectionCacheOptionsRequest, opts ...grpc.CallOption) (*SetConnectionCacheOptionsResponse, error)
+	GetConnectionConfigSchema(ctx context.Context, in *GetConnectionConfigSchemaRequest, opts ...grpc.CallOption) (*GetConnectionConfigSchemaResponse, error)
+	TestConnection(ctx context.Context, in *TestConnectionRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error)
 }
 
 type wrapperPluginClient struct {
@@ -202,6 +206,24 @@ func (c *wrapperPluginClient) SetConnectionCacheOptions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *wrapperPluginClient) GetConnectionConfigSchema(ctx context.Context, in *GetConnectionConfigSchemaRequest, opts ...grpc.CallOption) (*GetConnectionConfigSchemaResponse, error) {
+	out := new(GetConnectionConfigSchemaResponse)
+	err := c.cc.Invoke(ctx, WrapperPlugin_GetConnectionConfigSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wrapperPluginClient) TestConnection(ctx context.Context, in *TestConnectionRequest, opts ...grpc.CallOption) (*TestConnectionResponse, error) {
+	out := new(TestConnectionResponse)
+	err := c.cc.Invoke(ctx, WrapperPlugin_TestConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WrapperPluginServer is the server API for WrapperPlugin service.
 // All implementations must embed UnimplementedWrapperPluginServer
 // for forward compatibility
@@ -217,6 +239,8 @@ type WrapperPluginServer interface {
 	SetRateLimiters(context.Context, *SetRateLimitersRequest) (*SetRateLimitersResponse, error)
 	GetRateLimiters(context.Context, *GetRateLimitersRequest) (*GetRateLimitersResponse, error)
 	SetConnectionCacheOptions(context.Context, *SetConnectionCacheOptionsRequest) (*SetConnectionCacheOptionsResponse, error)
+	GetConnectionConfigSchema(context.Context, *GetConnectionConfigSchemaRequest) (*GetConnectionConfigSchemaResponse, error)
+	TestConnection(context.Context, *TestConnectionRequest) (*TestConnectionResponse, error)
 	mustEmbedUnimplementedWrapperPluginServer()
 }
 
@@ -257,6 +281,12 @@ func (UnimplementedWrapperPluginServer) GetRateLimiters(context.Context, *GetRat
 func (UnimplementedWrapperPluginServer) SetConnectionCacheOptions(context.Context, *SetConnectionCacheOptionsRequest) (*SetConnectionCacheOptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetConnectionCacheOptions not implemented")
 }
+func (UnimplementedWrapperPluginServer) GetConnectionConfigSchema(context.Context, *GetConnectionConfigSchemaRequest) (*GetConnectionConfigSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionConfigSchema not implemented")
+}
+func (UnimplementedWrapperPluginServer) TestConnection(context.Context, *TestConnectionRequest) (*TestConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestConnection not implemented")
+}
 func (UnimplementedWrapperPluginServer) mustEmbedUnimplementedWrapperPluginServer() {}
 
 // UnsafeWrapperPluginServer may be embedded to opt out of forward compatibility for this service.
@@ -474,6 +504,42 @@ func _WrapperPlugin_SetConnectionCacheOptions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WrapperPlugin_GetConnectionConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionConfigSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WrapperPluginServer).GetConnectionConfigSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WrapperPlugin_GetConnectionConfigSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WrapperPluginServer).GetConnectionConfigSchema(ctx, req.(*GetConnectionConfigSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WrapperPlugin_TestConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WrapperPluginServer).TestConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WrapperPlugin_TestConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WrapperPluginServer).TestConnection(ctx, req.(*TestConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WrapperPlugin_ServiceDesc is the grpc.ServiceDesc for WrapperPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +583,14 @@ var WrapperPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetConnectionCacheOptions",
 			Handler:    _WrapperPlugin_SetConnectionCacheOptions_Handler,
 		},
+		{
+			MethodName: "GetConnectionConfigSchema",
+			Handler:    _WrapperPlugin_GetConnectionConfigSchema_Handler,
+		},
+		{
+			MethodName: "TestConnection",
+			Handler:    _WrapperPlugin_TestConnection_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
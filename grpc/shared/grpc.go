@@ -61,6 +61,14 @@ func (c *GRPCClient) GetRateLimiters(req *proto.GetRateLimitersRequest) (*proto.
 	return c.client.GetRateLimiters(c.ctx, req)
 }
 
+func (c *GRPCClient) GetConnectionConfigSchema(req *proto.GetConnectionConfigSchemaRequest) (*proto.GetConnectionConfigSchemaResponse, error) {
+	return c.client.GetConnectionConfigSchema(c.ctx, req)
+}
+
+func (c *GRPCClient) TestConnection(req *proto.TestConnectionRequest) (*proto.TestConnectionResponse, error) {
+	return c.client.TestConnection(c.ctx, req)
+}
+
 // GRPCServer is the gRPC server that GRPCClient talks to.
 type GRPCServer struct {
 	proto.UnimplementedWrapperPluginServer
@@ -107,6 +115,12 @@ func (m *GRPCServer) SetRateLimiters(_ context.Context, req *proto.SetRateLimite
 func (m *GRPCServer) GetRateLimiters(_ context.Context, req *proto.GetRateLimitersRequest) (*proto.GetRateLimitersResponse, error) {
 	return m.Impl.GetRateLimiters(req)
 }
+func (m *GRPCServer) GetConnectionConfigSchema(_ context.Context, req *proto.GetConnectionConfigSchemaRequest) (*proto.GetConnectionConfigSchemaResponse, error) {
+	return m.Impl.GetConnectionConfigSchema(req)
+}
+func (m *GRPCServer) TestConnection(ctx context.Context, req *proto.TestConnectionRequest) (*proto.TestConnectionResponse, error) {
+	return m.Impl.TestConnection(ctx, req)
+}
 
 func (m *GRPCServer) EstablishMessageStream(_ *proto.EstablishMessageStreamRequest, server proto.WrapperPlugin_EstablishMessageStreamServer) error {
 	return m.Impl.EstablishMessageStream(server)
@@ -33,6 +33,8 @@ type WrapperPluginServer interface {
 	GetRateLimiters(req *proto.GetRateLimitersRequest) (*proto.GetRateLimitersResponse, error)
 	EstablishMessageStream(server proto.WrapperPlugin_EstablishMessageStreamServer) error
 	SetConnectionCacheOptions(req *proto.SetConnectionCacheOptionsRequest) (*proto.SetConnectionCacheOptionsResponse, error)
+	GetConnectionConfigSchema(req *proto.GetConnectionConfigSchemaRequest) (*proto.GetConnectionConfigSchemaResponse, error)
+	TestConnection(ctx context.Context, req *proto.TestConnectionRequest) (*proto.TestConnectionResponse, error)
 }
 
 type WrapperPluginClient interface {
@@ -47,6 +49,8 @@ type WrapperPluginClient interface {
 	GetRateLimiters(req *proto.GetRateLimitersRequest) (*proto.GetRateLimitersResponse, error)
 	EstablishMessageStream() (proto.WrapperPlugin_EstablishMessageStreamClient, error)
 	SetConnectionCacheOptions(req *proto.SetConnectionCacheOptionsRequest) (*proto.SetConnectionCacheOptionsResponse, error)
+	GetConnectionConfigSchema(req *proto.GetConnectionConfigSchemaRequest) (*proto.GetConnectionConfigSchemaResponse, error)
+	TestConnection(req *proto.TestConnectionRequest) (*proto.TestConnectionResponse, error)
 }
 
 // This is the implementation of plugin.GRPCServer so we can serve/consume this.
@@ -0,0 +1,352 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/error_helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+)
+
+const (
+	defaultCircuitBreakerWindow   = 1 * time.Minute
+	defaultCircuitBreakerCoolDown = 30 * time.Second
+)
+
+/*
+CircuitBreakerConfig fails [HydrateFunc] calls fast when an API is repeatedly failing.
+
+Without a circuit breaker, when an API (or API region) is down, every call retries with backoff before failing,
+so a query may take minutes to fail.
+
+When FailureThreshold calls fail within Window, the circuit breaker opens and all calls sharing the breaker fail
+immediately with a [CircuitBreakerOpenError] for the CoolDown period. After the cool-down, a single probe call
+is allowed through - if it succeeds the breaker closes, otherwise it opens again.
+
+Circuit breakers are scoped in the same way as rate limiters. One breaker instance is created for each combination
+of the Scope values, which are resolved from the connection name, table name, function name, matrix quals and tags.
+Calls with the same config settings and the same values for the Scope properties share a breaker (and therefore its failure count).
+
+Set [plugin.HydrateConfig.CircuitBreakerConfig], [plugin.ListConfig.CircuitBreakerConfig] or [plugin.GetConfig.CircuitBreakerConfig],
+or define a default by setting [plugin.Plugin.DefaultCircuitBreakerConfig]:
+
+	DefaultCircuitBreakerConfig: &plugin.CircuitBreakerConfig{
+		FailureThreshold: 5,
+		Window:           time.Minute,
+		CoolDown:         30 * time.Second,
+		// share a breaker for all calls to the same region of a connection
+		Scope: []string{"connection", "region"},
+	},
+
+Errors which are ignored by the [IgnoreConfig] of the call or by the ignore_errors rules of the connection config,
+and context cancellation errors, are not counted as failures.
+*/
+type CircuitBreakerConfig struct {
+	// the number of failures within Window which opens the breaker
+	FailureThreshold int
+	// the period over which failures are counted. Default set to 1 minute.
+	Window time.Duration
+	// how long the breaker stays open before a probe call is allowed. Default set to 30 seconds.
+	CoolDown time.Duration
+	// the scope properties which identify a breaker instance. Default set to connection and function name.
+	Scope []string
+	// optional function which determines whether an error counts as a failure.
+	// if not set, all errors which are not ignored count as failures
+	ShouldTripErrorFunc ErrorPredicateWithContext
+}
+
+func (c *CircuitBreakerConfig) String() string {
+	if c == nil {
+		return ""
+	}
+	return fmt.Sprintf("FailureThreshold: %d, Window: %s, CoolDown: %s, Scope: %s", c.FailureThreshold, c.Window, c.CoolDown, strings.Join(c.Scope, ","))
+}
+
+// key identifies the config settings - configs with the same settings share breaker instances
+func (c *CircuitBreakerConfig) key() string {
+	var shouldTripErrorFunc string
+	if c.ShouldTripErrorFunc != nil {
+		shouldTripErrorFunc = helpers.GetFunctionName(c.ShouldTripErrorFunc)
+	}
+	return fmt.Sprintf("%s, ShouldTripErrorFunc: %s", c.String(), shouldTripErrorFunc)
+}
+
+func (c *CircuitBreakerConfig) initialise() {
+	if c.Window == 0 {
+		c.Window = defaultCircuitBreakerWindow
+	}
+	if c.CoolDown == 0 {
+		c.CoolDown = defaultCircuitBreakerCoolDown
+	}
+	if len(c.Scope) == 0 {
+		c.Scope = []string{rate_limiter.RateLimiterScopeConnection, rate_limiter.RateLimiterScopeFunction}
+	}
+}
+
+func (c *CircuitBreakerConfig) validate(table *Table) []string {
+	var res []string
+
+	var tablePrefix string
+	if table != nil {
+		tablePrefix = fmt.Sprintf("table '%s': ", table.Name)
+	}
+	if c.FailureThreshold <= 0 {
+		res = append(res, fmt.Sprintf("%sCircuitBreakerConfig FailureThreshold must be greater than zero", tablePrefix))
+	}
+	if c.Window < 0 {
+		res = append(res, fmt.Sprintf("%sCircuitBreakerConfig Window cannot be negative", tablePrefix))
+	}
+	if c.CoolDown < 0 {
+		res = append(res, fmt.Sprintf("%sCircuitBreakerConfig CoolDown cannot be negative", tablePrefix))
+	}
+	return res
+}
+
+// CircuitBreakerOpenError is returned when a hydrate call is not made because its circuit breaker is open
+type CircuitBreakerOpenError struct {
+	// the scope values identifying the breaker
+	ScopeValues map[string]string
+	// the number of failures which opened the breaker
+	Failures int
+	// the time after which a probe call will be allowed
+	RetryAt time.Time
+	// the last error which was counted as a failure
+	LastError error
+}
+
+func (e *CircuitBreakerOpenError) Error() string {
+	msg := fmt.Sprintf("circuit breaker open (%s) after %d failures - failing fast until %s", rate_limiter.ScopeValuesString(e.ScopeValues), e.Failures, e.RetryAt.Format(time.RFC3339))
+	if e.LastError != nil {
+		msg = fmt.Sprintf("%s: %s", msg, e.LastError.Error())
+	}
+	return msg
+}
+
+type circuitBreakerState int
+
+const (
+	circuitBreakerClosed circuitBreakerState = iota
+	circuitBreakerOpen
+	circuitBreakerHalfOpen
+)
+
+// circuitBreaker is an instance of a breaker, for a specific set of scope values
+type circuitBreaker struct {
+	config      *CircuitBreakerConfig
+	scopeValues map[string]string
+
+	state         circuitBreakerState
+	failures      []time.Time
+	openedAt      time.Time
+	lastError     error
+	probeInFlight bool
+	mut           sync.Mutex
+}
+
+func newCircuitBreaker(config *CircuitBreakerConfig, scopeValues map[string]string) *circuitBreaker {
+	return &circuitBreaker{
+		config:      config,
+		scopeValues: scopeValues,
+	}
+}
+
+// allow returns a CircuitBreakerOpenError if the call may not be made
+// if the breaker allows a probe call, it returns isProbe=true
+func (b *circuitBreaker) allow() (isProbe bool, err error) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	switch b.state {
+	case circuitBreakerOpen:
+		if time.Since(b.openedAt) < b.config.CoolDown {
+			return false, b.openError()
+		}
+		// cool-down has elapsed - allow a probe
+		log.Printf("[INFO] circuit breaker (%s) cool-down elapsed - allowing probe call", rate_limiter.ScopeValuesString(b.scopeValues))
+		b.state = circuitBreakerHalfOpen
+		b.probeInFlight = true
+		return true, nil
+	case circuitBreakerHalfOpen:
+		// only a single probe call is allowed
+		if b.probeInFlight {
+			return false, b.openError()
+		}
+		b.probeInFlight = true
+		return true, nil
+	}
+	return false, nil
+}
+
+// onSuccess records a successful call
+func (b *circuitBreaker) onSuccess(isProbe bool) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	if isProbe {
+		log.Printf("[INFO] circuit breaker (%s) probe call succeeded - closing", rate_limiter.ScopeValuesString(b.scopeValues))
+		b.probeInFlight = false
+		b.state = circuitBreakerClosed
+		b.failures = nil
+		b.lastError = nil
+	}
+}
+
+// onFailure records a failed call, opening the breaker if required
+func (b *circuitBreaker) onFailure(isProbe bool, err error) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	now := time.Now()
+	b.lastError = err
+
+	if isProbe {
+		log.Printf("[INFO] circuit breaker (%s) probe call failed - reopening", rate_limiter.ScopeValuesString(b.scopeValues))
+		b.probeInFlight = false
+		b.state = circuitBreakerOpen
+		b.openedAt = now
+		return
+	}
+
+	// remove failures which are outside the window
+	windowStart := now.Add(-b.config.Window)
+	var failures []time.Time
+	for _, f := range b.failures {
+		if f.After(windowStart) {
+			failures = append(failures, f)
+		}
+	}
+	b.failures = append(failures, now)
+
+	if b.state == circuitBreakerClosed && len(b.failures) >= b.config.FailureThreshold {
+		log.Printf("[WARN] circuit breaker (%s) opening after %d failures in %s: %s", rate_limiter.ScopeValuesString(b.scopeValues), len(b.failures), b.config.Window, err.Error())
+		b.state = circuitBreakerOpen
+		b.openedAt = now
+	}
+}
+
+// onAbandoned releases a probe call which neither succeeded nor failed (e.g. the error was ignored)
+func (b *circuitBreaker) onAbandoned(isProbe bool) {
+	if !isProbe {
+		return
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	b.probeInFlight = false
+}
+
+// NOTE: the lock must be held by the caller
+func (b *circuitBreaker) openError() error {
+	return &CircuitBreakerOpenError{
+		ScopeValues: b.scopeValues,
+		Failures:    len(b.failures),
+		RetryAt:     b.openedAt.Add(b.config.CoolDown),
+		LastError:   b.lastError,
+	}
+}
+
+// shouldTrip returns whether the given error counts as a failure
+func (b *circuitBreaker) shouldTrip(ctx context.Context, d *QueryData, h *HydrateData, hydrateName string, err error, ignoreConfig *IgnoreConfig) bool {
+	if error_helpers.IsContextCancelledError(err) {
+		return false
+	}
+	// ignored errors are not failures
+	if ignoreConfig != nil && ignoreConfig.shouldIgnoreError(ctx, d, h, err) {
+		return false
+	}
+	// nor are errors ignored by the user defined connection config rules
+	if getConnectionErrorRules(d).shouldIgnoreError(ctx, d, hydrateName, err) {
+		return false
+	}
+	if b.config.ShouldTripErrorFunc != nil {
+		return b.config.ShouldTripErrorFunc(ctx, d, h, err)
+	}
+	return true
+}
+
+// wrap returns a hydrate func which checks the breaker before every call and records the result
+func (b *circuitBreaker) wrap(hydrate namedHydrateFunc, ignoreConfig *IgnoreConfig) namedHydrateFunc {
+	// a nil breaker is valid - just return the hydrate func
+	if b == nil {
+		return hydrate
+	}
+	res := hydrate.clone()
+	res.Func = func(ctx context.Context, d *QueryData, h *HydrateData) (interface{}, error) {
+		isProbe, err := b.allow()
+		if err != nil {
+			log.Printf("[TRACE] hydrate call %s not made: %s", hydrate.Name, err.Error())
+			return nil, err
+		}
+		// if the hydrate func panics, release the probe
+		completed := false
+		defer func() {
+			if !completed {
+				b.onAbandoned(isProbe)
+			}
+		}()
+		item, err := hydrate.Func(ctx, d, h)
+		completed = true
+		switch {
+		case err == nil:
+			b.onSuccess(isProbe)
+		case b.shouldTrip(ctx, d, h, hydrate.Name, err, ignoreConfig):
+			b.onFailure(isProbe, err)
+		default:
+			b.onAbandoned(isProbe)
+		}
+		return item, err
+	}
+	return res
+}
+
+// circuitBreakerMap is a map of circuit breaker instances, keyed by config settings and scope values
+type circuitBreakerMap struct {
+	breakers map[string]*circuitBreaker
+	mut      sync.Mutex
+}
+
+func newCircuitBreakerMap() *circuitBreakerMap {
+	return &circuitBreakerMap{
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+// getOrCreate returns the breaker for the given config and scope values, creating it if necessary
+// (calls whose configs have different settings do not share a breaker, even if their scope values are the same)
+// NOTE: if the scope values do not include all properties of the config Scope, the breaker does not apply
+// and nil is returned
+func (m *circuitBreakerMap) getOrCreate(config *CircuitBreakerConfig, scopeValues map[string]string) *circuitBreaker {
+	if config == nil {
+		return nil
+	}
+	requiredScopeValues := helpers.FilterMap(scopeValues, config.Scope)
+	if len(requiredScopeValues) < len(config.Scope) {
+		log.Printf("[INFO] we DO NOT have scope values required by circuit breaker - it requires: %s", strings.Join(config.Scope, ","))
+		return nil
+	}
+	key := fmt.Sprintf("%s/%s", config.key(), rate_limiter.ScopeValuesString(requiredScopeValues))
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	b, ok := m.breakers[key]
+	if !ok {
+		b = newCircuitBreaker(config, requiredScopeValues)
+		m.breakers[key] = b
+	}
+	return b
+}
+
+// clearForConnection removes all breakers for the given connection
+// (e.g. when the connection config changes, a previously failing connection may now succeed)
+func (m *circuitBreakerMap) clearForConnection(connectionName string) {
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	for key, b := range m.breakers {
+		if b.scopeValues[rate_limiter.RateLimiterScopeConnection] == connectionName {
+			delete(m.breakers, key)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	config := &CircuitBreakerConfig{FailureThreshold: 2, CoolDown: 50 * time.Millisecond}
+	config.initialise()
+
+	m := newCircuitBreakerMap()
+	scopeValues := map[string]string{"connection": "c1", "function_name": "getThing", "table": "t1"}
+	b := m.getOrCreate(config, scopeValues)
+	if b == nil {
+		t.Fatal("expected a circuit breaker")
+	}
+	if other := m.getOrCreate(config, map[string]string{"connection": "c1", "function_name": "getThing", "table": "t2"}); other != b {
+		t.Error("expected calls with the same scope values to share a circuit breaker")
+	}
+	if other := m.getOrCreate(config, map[string]string{"connection": "c1"}); other != nil {
+		t.Error("expected no circuit breaker when scope values are missing")
+	}
+	// configs with the same settings share a breaker, configs with different settings do not
+	sameConfig := *config
+	if other := m.getOrCreate(&sameConfig, scopeValues); other != b {
+		t.Error("expected calls with the same config settings to share a circuit breaker")
+	}
+	otherConfig := &CircuitBreakerConfig{FailureThreshold: 10}
+	otherConfig.initialise()
+	if other := m.getOrCreate(otherConfig, scopeValues); other == b || other.config != otherConfig {
+		t.Error("expected calls with different config settings to use different circuit breakers")
+	}
+
+	var calls int
+	var callErr = errors.New("service unavailable")
+	rawHydrate := newNamedHydrateFunc(func(context.Context, *QueryData, *HydrateData) (interface{}, error) {
+		calls++
+		return nil, callErr
+	})
+	hydrate := b.wrap(rawHydrate, &IgnoreConfig{})
+
+	// the first 2 calls fail and open the breaker
+	for i := 0; i < 2; i++ {
+		if _, err := hydrate.Func(context.Background(), nil, nil); err != callErr {
+			t.Fatalf("call %d: expected hydrate error, got %v", i, err)
+		}
+	}
+	// the next call fails fast
+	_, err := hydrate.Func(context.Background(), nil, nil)
+	var openErr *CircuitBreakerOpenError
+	if !errors.As(err, &openErr) {
+		t.Fatalf("expected CircuitBreakerOpenError, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+	if shouldRetryError(context.Background(), nil, nil, "getThing", err, &RetryConfig{ShouldRetryError: func(error) bool { return true }}) {
+		t.Error("expected CircuitBreakerOpenError not to be retried")
+	}
+
+	// after the cool-down, a successful probe closes the breaker
+	time.Sleep(60 * time.Millisecond)
+	callErr = nil
+	if _, err := hydrate.Func(context.Background(), nil, nil); err != nil {
+		t.Fatalf("expected probe call to succeed, got %v", err)
+	}
+	if _, err := hydrate.Func(context.Background(), nil, nil); err != nil {
+		t.Fatalf("expected breaker to be closed, got %v", err)
+	}
+	if calls != 4 {
+		t.Errorf("expected 4 calls, got %d", calls)
+	}
+
+	// ignored errors do not count as failures
+	callErr = errors.New("not found")
+	ignoring := b.wrap(rawHydrate, &IgnoreConfig{ShouldIgnoreError: func(error) bool { return true }})
+	for i := 0; i < 3; i++ {
+		if _, err := ignoring.Func(context.Background(), nil, nil); err != callErr {
+			t.Fatalf("call %d: expected hydrate error, got %v", i, err)
+		}
+	}
+	if _, err := hydrate.Func(context.Background(), nil, nil); err == nil || errors.As(err, &openErr) {
+		t.Errorf("expected breaker to be closed, got %v", err)
+	}
+
+	// clearing the connection removes the breaker
+	m.clearForConnection("c1")
+	if other := m.getOrCreate(config, scopeValues); other == b {
+		t.Error("expected a new circuit breaker after clearing the connection")
+	}
+}
+
+func TestCircuitBreakerConnectionErrorRules(t *testing.T) {
+	sdkConfig, _, err := parseSdkConnectionConfig(&proto.ConnectionConfig{Connection: "c1", Config: `ignore_errors = ["code = 'AccessDenied'"]`}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &QueryData{
+		Table:      &Table{Name: "t1"},
+		Connection: &Connection{Name: "c1", errorRules: sdkConfig.errorRules},
+	}
+
+	config := &CircuitBreakerConfig{FailureThreshold: 2}
+	config.initialise()
+	b := newCircuitBreakerMap().getOrCreate(config, map[string]string{"connection": "c1", "function_name": "getThing"})
+
+	callErr := testCodedError{code: "AccessDenied", httpStatus: 403}
+	hydrate := b.wrap(newNamedHydrateFunc(func(context.Context, *QueryData, *HydrateData) (interface{}, error) {
+		return nil, callErr
+	}), &IgnoreConfig{})
+
+	// errors ignored by the connection config rules are not counted as failures
+	for i := 0; i < 3; i++ {
+		if _, err := hydrate.Func(context.Background(), d, nil); !errors.Is(err, callErr) {
+			t.Fatalf("call %d: expected hydrate error, got %v", i, err)
+		}
+	}
+
+	// without the rules, the errors open the breaker
+	d.Connection.errorRules = nil
+	for i := 0; i < 2; i++ {
+		hydrate.Func(context.Background(), d, nil)
+	}
+	var openErr *CircuitBreakerOpenError
+	if _, err := hydrate.Func(context.Background(), d, nil); !errors.As(err, &openErr) {
+		t.Errorf("expected circuit breaker open error, got %v", err)
+	}
+}
@@ -0,0 +1,492 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/sync/singleflight"
+)
+
+/*
+ClientFactory defines how the API clients of a plugin are created, refreshed and closed.
+
+If [plugin.Plugin.ClientFactory] is set, clients are retrieved using [plugin.QueryData.GetClient].
+Clients are created lazily, the first time they are requested, and are then reused by all queries for the connection.
+Concurrent requests for the same client result in a single call to Create.
+
+Clients are closed automatically when the connection config changes, when the connection is deleted, and when the plugin shuts down.
+A client is not closed until the queries using it have completed.
+If the connection config changes while a client is being created, that client is closed and a new client is created using the new config.
+
+Usage:
+
+	p := &plugin.Plugin{
+		Name: "steampipe-plugin-aws",
+		ClientFactory: &plugin.ClientFactory{
+			Create:     newEC2Client,
+			MatrixKeys: []string{"region"},
+		},
+		...
+	}
+
+	func newEC2Client(ctx context.Context, c *plugin.Connection, matrixItem map[string]any) (any, time.Time, error) {
+		cfg, err := getAwsConfig(ctx, c.Config.(awsConfig), matrixItem["region"].(string))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return ec2.NewFromConfig(cfg), cfg.Credentials.Expires, nil
+	}
+
+	func listInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (any, error) {
+		client, err := d.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		svc := client.(*ec2.Client)
+		...
+	}
+*/
+type ClientFactory struct {
+	// Create creates a client for the connection (and matrix item, if MatrixKeys is set).
+	// It returns the client, and the time its credentials expire (zero if they do not expire).
+	Create ClientCreateFunc
+	// Refresh is an optional function which refreshes the credentials of a client which is about to expire.
+	// If Refresh is not set (or it fails), the client is closed and a new client is created.
+	Refresh ClientRefreshFunc
+	// Close is an optional function which releases the resources held by a client.
+	Close ClientCloseFunc
+	// the matrix item keys the client depends on (e.g. "region") - a client is created for each combination of values.
+	// If not set, there is a single client for each connection.
+	MatrixKeys []string
+	// how long before its credentials expire a client is refreshed (defaults to 1 minute)
+	RefreshBefore time.Duration
+	// the timeout for each Create and Refresh call (defaults to 1 minute)
+	// NOTE: these calls are shared by all callers requesting the client, so are not cancelled if the caller is cancelled
+	Timeout time.Duration
+}
+
+// ClientCreateFunc is a function type which creates an API client. It is used to implement [plugin.ClientFactory.Create].
+type ClientCreateFunc func(ctx context.Context, connection *Connection, matrixItem map[string]any) (client any, expiresAt time.Time, err error)
+
+// ClientRefreshFunc is a function type which refreshes the credentials of an API client, returning the new expiry time.
+// It is used to implement [plugin.ClientFactory.Refresh].
+type ClientRefreshFunc func(ctx context.Context, connection *Connection, client any) (expiresAt time.Time, err error)
+
+// ClientCloseFunc is a function type which closes an API client. It is used to implement [plugin.ClientFactory.Close].
+type ClientCloseFunc func(client any) error
+
+const (
+	defaultClientRefreshBefore = time.Minute
+	defaultClientTimeout       = time.Minute
+)
+
+func (f *ClientFactory) initialise() {
+	if f.RefreshBefore == 0 {
+		f.RefreshBefore = defaultClientRefreshBefore
+	}
+	if f.Timeout == 0 {
+		f.Timeout = defaultClientTimeout
+	}
+}
+
+// validate validates the client factory
+func (f *ClientFactory) validate() []string {
+	if f.Create == nil {
+		return []string{"ClientFactory does not specify a Create function"}
+	}
+	var validationErrors []string
+	if f.RefreshBefore < 0 {
+		validationErrors = append(validationErrors, "ClientFactory RefreshBefore cannot be negative")
+	}
+	if f.Timeout < 0 {
+		validationErrors = append(validationErrors, "ClientFactory Timeout cannot be negative")
+	}
+	return validationErrors
+}
+
+type registeredClient struct {
+	key            string
+	connectionName string
+	client         any
+	expiresAt      time.Time
+	// the number of queries using the client
+	refs int
+	// has the client been removed from the registry - if so, it is closed when it is no longer in use
+	removed bool
+}
+
+// clientRegistry stores the clients created by a ClientFactory, keyed by connection name and matrix item
+type clientRegistry struct {
+	factory *ClientFactory
+	clients map[string]*registeredClient
+	// the number of times the clients of each connection have been closed
+	// this is used to avoid storing a client which was created using the previous connection config
+	generations map[string]int
+	// returns whether the config of the given connection is the current config for the connection
+	isCurrent func(connection *Connection) bool
+	mut       sync.Mutex
+	group     singleflight.Group
+}
+
+func newClientRegistry(factory *ClientFactory, isCurrent func(connection *Connection) bool) *clientRegistry {
+	factory.initialise()
+	return &clientRegistry{
+		factory:     factory,
+		clients:     make(map[string]*registeredClient),
+		generations: make(map[string]int),
+		isCurrent:   isCurrent,
+	}
+}
+
+// get returns the client for the connection and matrix item, creating or refreshing it if necessary
+func (r *clientRegistry) get(ctx context.Context, connection *Connection, matrixItem map[string]any) (*registeredClient, error) {
+	matrixItem = r.clientMatrixItem(matrixItem)
+	key := clientRegistryKey(connection.Name, matrixItem)
+
+	if c, ok := r.current(key); ok {
+		return c, nil
+	}
+
+	// the create/refresh call is shared by all callers requesting the client, so must not use the context of this caller
+	// - use a detached context (retaining the context values), with a timeout
+	// - if this caller is cancelled, stop waiting for the call to complete
+	resultChan := r.group.DoChan(key, func() (any, error) {
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.factory.Timeout)
+		defer cancel()
+		return r.createOrRefresh(callCtx, key, connection, matrixItem)
+	})
+	select {
+	case res := <-resultChan:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*registeredClient), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// current returns the registered client for the key, if it exists and does not need refreshing
+func (r *clientRegistry) current(key string) (*registeredClient, bool) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	c, ok := r.clients[key]
+	if !ok || r.needsRefresh(c) {
+		return nil, false
+	}
+	return c, true
+}
+
+func (r *clientRegistry) createOrRefresh(ctx context.Context, key string, connection *Connection, matrixItem map[string]any) (*registeredClient, error) {
+	r.mut.Lock()
+	c, ok := r.clients[key]
+	refresh := ok && r.needsRefresh(c)
+	r.mut.Unlock()
+
+	switch {
+	case ok && !refresh:
+		// another caller has refreshed the client
+		return c, nil
+	case ok && r.factory.Refresh != nil:
+		log.Printf("[INFO] refreshing client for connection '%s' (%s)", connection.Name, key)
+		expiresAt, err := r.factory.Refresh(ctx, connection, c.client)
+		if err == nil {
+			r.mut.Lock()
+			c.expiresAt = expiresAt
+			r.mut.Unlock()
+			return c, nil
+		}
+		log.Printf("[WARN] failed to refresh client for connection '%s' (%s), creating a new client: %s", connection.Name, key, err.Error())
+		fallthrough
+	case ok:
+		r.remove(key, c)
+	}
+
+	for {
+		c, err := r.create(ctx, key, connection, matrixItem)
+		if err != nil || c != nil {
+			return c, err
+		}
+		// the connection config changed while the client was being created - create a client using the new config
+		log.Printf("[INFO] retrying creating client for connection '%s' (%s)", connection.Name, key)
+	}
+}
+
+// create creates a client using the current config of the connection, and stores it in the registry
+// if the connection config changes while the client is being created, the client is closed and nil is returned
+func (r *clientRegistry) create(ctx context.Context, key string, connection *Connection, matrixItem map[string]any) (*registeredClient, error) {
+	// NOTE: read the generation BEFORE copying the connection
+	// - the config is updated before the clients are closed, so if the copied config is stale, the generation will change
+	r.mut.Lock()
+	generation := r.generations[connection.Name]
+	r.mut.Unlock()
+	// the Connection is updated in place when the config changes, so copy it to ensure the client is created using a single config
+	connection = connection.shallowCopy()
+	if !r.isCurrent(connection) {
+		return nil, fmt.Errorf("cannot create client for connection '%s' as the connection config has changed", connection.Name)
+	}
+
+	log.Printf("[INFO] creating client for connection '%s' (%s)", connection.Name, key)
+	client, expiresAt, err := r.factory.Create(ctx, connection, matrixItem)
+	if err != nil {
+		return nil, err
+	}
+	c := &registeredClient{key: key, connectionName: connection.Name, client: client, expiresAt: expiresAt}
+	if r.isCurrent(connection) && r.store(key, generation, c) {
+		return c, nil
+	}
+	// the client was created using a stale config and is not in use - close it
+	log.Printf("[INFO] config for connection '%s' changed while creating client %s - closing the client", connection.Name, key)
+	r.closeClient(key, c)
+	return nil, nil
+}
+
+// store adds the client to the registry, unless the clients of the connection have been closed since it was requested
+// returns whether the client was stored
+func (r *clientRegistry) store(key string, generation int, c *registeredClient) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	if r.generations[c.connectionName] != generation {
+		return false
+	}
+	r.clients[key] = c
+	return true
+}
+
+// acquire adds a reference to the client, so it is not closed until released
+// returns false if the client has been removed from the registry
+func (r *clientRegistry) acquire(c *registeredClient) bool {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	if c.removed {
+		return false
+	}
+	c.refs++
+	return true
+}
+
+// release removes a reference to the client, closing it if it has been removed and is no longer in use
+func (r *clientRegistry) release(c *registeredClient) {
+	r.mut.Lock()
+	c.refs--
+	closeClient := c.removed && c.refs == 0
+	r.mut.Unlock()
+	if closeClient {
+		r.closeClient(c.key, c)
+	}
+}
+
+// remove removes the client from the registry (if it has not already been replaced)
+// it is closed if it is not in use (otherwise it is closed when released)
+func (r *clientRegistry) remove(key string, c *registeredClient) {
+	r.mut.Lock()
+	if r.clients[key] == c {
+		delete(r.clients, key)
+	}
+	closeClient := r.markRemoved(c)
+	r.mut.Unlock()
+	if closeClient {
+		r.closeClient(key, c)
+	}
+}
+
+// closeConnection closes all clients for the given connections
+// (clients which are in use are closed when they are released)
+func (r *clientRegistry) closeConnection(connectionNames ...string) {
+	r.mut.Lock()
+	var closed = make(map[string]*registeredClient)
+	for _, connectionName := range connectionNames {
+		r.generations[connectionName]++
+		for key, c := range r.clients {
+			if c.connectionName == connectionName {
+				delete(r.clients, key)
+				if r.markRemoved(c) {
+					closed[key] = c
+				}
+			}
+		}
+	}
+	r.mut.Unlock()
+
+	for key, c := range closed {
+		r.closeClient(key, c)
+	}
+}
+
+// markRemoved marks the client as removed, returning whether it should be closed now (i.e. it is not in use)
+// NOTE: this must be called with the lock held
+func (r *clientRegistry) markRemoved(c *registeredClient) bool {
+	if c.removed {
+		return false
+	}
+	c.removed = true
+	return c.refs == 0
+}
+
+// closeAll closes all clients
+func (r *clientRegistry) closeAll() {
+	r.mut.Lock()
+	connectionNames := make(map[string]struct{})
+	for _, c := range r.clients {
+		connectionNames[c.connectionName] = struct{}{}
+	}
+	r.mut.Unlock()
+	r.closeConnection(maps.Keys(connectionNames)...)
+}
+
+func (r *clientRegistry) closeClient(key string, c *registeredClient) {
+	if r.factory.Close == nil {
+		return
+	}
+	log.Printf("[INFO] closing client for connection '%s' (%s)", c.connectionName, key)
+	if err := r.factory.Close(c.client); err != nil {
+		log.Printf("[WARN] failed to close client for connection '%s' (%s): %s", c.connectionName, key, err.Error())
+	}
+}
+
+// NOTE: this must be called with the lock held
+func (r *clientRegistry) needsRefresh(c *registeredClient) bool {
+	return !c.expiresAt.IsZero() && time.Now().Add(r.factory.RefreshBefore).After(c.expiresAt)
+}
+
+// clientMatrixItem returns the values of the matrix item which the client depends on
+func (r *clientRegistry) clientMatrixItem(matrixItem map[string]any) map[string]any {
+	if len(r.factory.MatrixKeys) == 0 || len(matrixItem) == 0 {
+		return nil
+	}
+	res := make(map[string]any, len(r.factory.MatrixKeys))
+	for _, k := range r.factory.MatrixKeys {
+		if v, ok := matrixItem[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func clientRegistryKey(connectionName string, matrixItem map[string]any) string {
+	if len(matrixItem) == 0 {
+		return connectionName
+	}
+	keys := maps.Keys(matrixItem)
+	slices.Sort(keys)
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		values[i] = fmt.Sprintf("%s=%v", k, matrixItem[k])
+	}
+	return fmt.Sprintf("%s/%s", connectionName, strings.Join(values, ","))
+}
+
+// queryClients holds a reference to each client used by a query, so the clients are not closed while the query is running
+// (it is shared by all copies of the QueryData for the query)
+type queryClients struct {
+	registry *clientRegistry
+	held     map[*registeredClient]struct{}
+	mut      sync.Mutex
+}
+
+func newQueryClients(registry *clientRegistry) *queryClients {
+	return &queryClients{
+		registry: registry,
+		held:     make(map[*registeredClient]struct{}),
+	}
+}
+
+// get returns the client for the connection and matrix item, holding a reference to it until the query completes
+func (q *queryClients) get(ctx context.Context, connection *Connection, matrixItem map[string]any) (any, error) {
+	for {
+		c, err := q.registry.get(ctx, connection, matrixItem)
+		if err != nil {
+			return nil, err
+		}
+		if q.hold(c) {
+			return c.client, nil
+		}
+		// the client was closed before we could acquire it - get the replacement
+		log.Printf("[INFO] client for connection '%s' (%s) was closed before it could be used - retrying", c.connectionName, c.key)
+	}
+}
+
+func (q *queryClients) hold(c *registeredClient) bool {
+	q.mut.Lock()
+	defer q.mut.Unlock()
+	if _, ok := q.held[c]; ok {
+		return true
+	}
+	if !q.registry.acquire(c) {
+		return false
+	}
+	q.held[c] = struct{}{}
+	return true
+}
+
+// releaseAll releases all clients held by the query
+func (q *queryClients) releaseAll() {
+	q.mut.Lock()
+	held := q.held
+	q.held = make(map[*registeredClient]struct{})
+	q.mut.Unlock()
+	for c := range held {
+		q.registry.release(c)
+	}
+}
+
+// GetClient returns the API client for the query connection, and the current matrix item (if [plugin.ClientFactory.MatrixKeys] is set).
+//
+// The client is created using [plugin.Plugin.ClientFactory] the first time it is requested,
+// and refreshed when its credentials are about to expire.
+// The client is not closed until the query completes.
+func (d *QueryData) GetClient(ctx context.Context) (any, error) {
+	if d.clients == nil {
+		return d.plugin.GetClient(ctx, d.Connection, getQueryMatrixItem(ctx, d))
+	}
+	return d.clients.get(ctx, d.Connection, getQueryMatrixItem(ctx, d))
+}
+
+// GetClient returns the API client for the connection and matrix item, creating it using [plugin.Plugin.ClientFactory] if necessary.
+//
+// NOTE: clients returned by this function may be closed at any time after the connection config changes.
+// When executing a query, use [plugin.QueryData.GetClient], which ensures the client is not closed until the query completes.
+func (p *Plugin) GetClient(ctx context.Context, connection *Connection, matrixItem map[string]any) (any, error) {
+	if p.clientRegistry == nil {
+		return nil, fmt.Errorf("plugin '%s' does not define a ClientFactory", p.Name)
+	}
+	if connection == nil {
+		return nil, fmt.Errorf("GetClient requires a connection")
+	}
+	c, err := p.clientRegistry.get(ctx, connection, matrixItem)
+	if err != nil {
+		return nil, err
+	}
+	return c.client, nil
+}
+
+// releaseClients releases the clients used by the query (closing any clients which have since been removed)
+func (d *QueryData) releaseClients() {
+	if d.clients != nil {
+		d.clients.releaseAll()
+	}
+}
+
+// isCurrentConnection returns whether the config of the given connection is the current config in the connection map
+// (if the connection is not in the connection map, it is assumed to be current)
+func (p *Plugin) isCurrentConnection(connection *Connection) bool {
+	connectionData, ok := p.getConnectionData(connection.Name)
+	if !ok {
+		return true
+	}
+	return reflect.DeepEqual(connectionData.Connection.Config, connection.Config)
+}
+
+// closeClients closes the clients for the given connections (if the plugin has a ClientFactory)
+func (p *Plugin) closeClients(connectionNames ...string) {
+	if p.clientRegistry != nil {
+		p.clientRegistry.closeConnection(connectionNames...)
+	}
+}
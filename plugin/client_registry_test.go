@@ -0,0 +1,294 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+type testClient struct {
+	name      string
+	refreshes int
+	closed    bool
+}
+
+// testClientFactory returns a client factory which records the clients it creates
+type testClientFactory struct {
+	created      atomic.Int32
+	refreshed    atomic.Int32
+	expiresIn    time.Duration
+	refreshError error
+	clients      []*testClient
+	mut          sync.Mutex
+}
+
+func (f *testClientFactory) factory() *ClientFactory {
+	return &ClientFactory{
+		Create: func(ctx context.Context, connection *Connection, matrixItem map[string]any) (any, time.Time, error) {
+			f.created.Add(1)
+			// give concurrent callers a chance to request the same client
+			time.Sleep(10 * time.Millisecond)
+			client := &testClient{name: clientRegistryKey(connection.Name, matrixItem)}
+			f.mut.Lock()
+			f.clients = append(f.clients, client)
+			f.mut.Unlock()
+			return client, f.expiresAt(), nil
+		},
+		Refresh: func(ctx context.Context, connection *Connection, client any) (time.Time, error) {
+			f.refreshed.Add(1)
+			if f.refreshError != nil {
+				return time.Time{}, f.refreshError
+			}
+			client.(*testClient).refreshes++
+			return f.expiresAt(), nil
+		},
+		Close: func(client any) error {
+			client.(*testClient).closed = true
+			return nil
+		},
+		MatrixKeys: []string{"region"},
+	}
+}
+
+func (f *testClientFactory) expiresAt() time.Time {
+	if f.expiresIn == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(f.expiresIn)
+}
+
+func (f *testClientFactory) closedCount() int {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	var res int
+	for _, c := range f.clients {
+		if c.closed {
+			res++
+		}
+	}
+	return res
+}
+
+func newClientRegistryTestPlugin(f *testClientFactory) *Plugin {
+	p := &Plugin{Name: "test", ClientFactory: f.factory()}
+	p.initialise(nil)
+	return p
+}
+
+func TestClientRegistryLazyCreation(t *testing.T) {
+	f := &testClientFactory{}
+	p := newClientRegistryTestPlugin(f)
+	connection := &Connection{Name: "c1"}
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	clients := make([]any, 20)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := p.GetClient(ctx, connection, map[string]any{"region": "us-east-1", "account": fmt.Sprintf("%d", i)})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	if created := f.created.Load(); created != 1 {
+		t.Fatalf("expected 1 client to be created, got %d", created)
+	}
+	for _, client := range clients {
+		if client != clients[0] {
+			t.Fatalf("expected all callers to get the same client")
+		}
+	}
+	// the matrix keys which are not in MatrixKeys should be ignored
+	if name := clients[0].(*testClient).name; name != "c1/region=us-east-1" {
+		t.Errorf("expected client c1/region=us-east-1, got %s", name)
+	}
+
+	// a different region should have a different client
+	client, _ := p.GetClient(ctx, connection, map[string]any{"region": "eu-west-1"})
+	if client == clients[0] || f.created.Load() != 2 {
+		t.Errorf("expected a new client for a different matrix item")
+	}
+}
+
+func TestClientRegistryRefresh(t *testing.T) {
+	// the credentials expire within the default RefreshBefore, so are refreshed every time
+	f := &testClientFactory{expiresIn: 30 * time.Second}
+	p := newClientRegistryTestPlugin(f)
+	connection := &Connection{Name: "c1"}
+	ctx := context.Background()
+
+	first, _ := p.GetClient(ctx, connection, nil)
+	second, _ := p.GetClient(ctx, connection, nil)
+	if first != second || f.refreshed.Load() != 1 || first.(*testClient).refreshes != 1 {
+		t.Errorf("expected the client to be refreshed, got %d refreshes", f.refreshed.Load())
+	}
+
+	// if the refresh fails, a new client is created and the old client is closed
+	f.refreshError = fmt.Errorf("refresh failed")
+	third, _ := p.GetClient(ctx, connection, nil)
+	if third == first || f.created.Load() != 2 || !first.(*testClient).closed {
+		t.Errorf("expected a new client to be created, and the old client to be closed")
+	}
+}
+
+func TestClientRegistryLifecycle(t *testing.T) {
+	f := &testClientFactory{}
+	p := newClientRegistryTestPlugin(f)
+	ctx := context.Background()
+	for _, name := range []string{"c1", "c2", "c3"} {
+		p.GetClient(ctx, &Connection{Name: name}, nil)
+	}
+
+	// a deleted connection closes its clients
+	p.deleteConnections([]*proto.ConnectionConfig{{Connection: "c1"}})
+	if closed := f.closedCount(); closed != 1 {
+		t.Errorf("expected 1 client to be closed after deleting a connection, got %d", closed)
+	}
+	// a new client is created for the connection if it is requested again
+	p.GetClient(ctx, &Connection{Name: "c1"}, nil)
+	if created := f.created.Load(); created != 4 {
+		t.Errorf("expected 4 clients to be created, got %d", created)
+	}
+
+	// shutdown closes all clients
+	p.shutdown()
+	if closed := f.closedCount(); closed != 4 {
+		t.Errorf("expected all clients to be closed after shutdown, got %d", closed)
+	}
+}
+
+func TestClientRegistryNoFactory(t *testing.T) {
+	p := &Plugin{Name: "test"}
+	p.initialise(nil)
+	if _, err := p.GetClient(context.Background(), &Connection{Name: "c1"}, nil); err == nil {
+		t.Errorf("expected an error if the plugin does not define a ClientFactory")
+	}
+}
+
+func TestClientRegistryInUseClientNotClosed(t *testing.T) {
+	f := &testClientFactory{}
+	p := newClientRegistryTestPlugin(f)
+	connection := &Connection{Name: "c1"}
+	ctx := context.Background()
+
+	// the query holds the client until it completes
+	clients := newQueryClients(p.clientRegistry)
+	client, err := clients.get(ctx, connection, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.closeClients("c1")
+	if client.(*testClient).closed {
+		t.Fatal("expected the client not to be closed while in use")
+	}
+	// new requests get a new client
+	if newClient, _ := p.GetClient(ctx, connection, nil); newClient == client {
+		t.Error("expected a new client after the connection clients were closed")
+	}
+
+	clients.releaseAll()
+	if !client.(*testClient).closed {
+		t.Error("expected the client to be closed when released")
+	}
+}
+
+func TestClientRegistryDetachedCreate(t *testing.T) {
+	// was the create context detached from the caller context (and given a timeout)
+	var detached atomic.Bool
+	p := &Plugin{Name: "test", ClientFactory: &ClientFactory{
+		Create: func(ctx context.Context, connection *Connection, matrixItem map[string]any) (any, time.Time, error) {
+			time.Sleep(50 * time.Millisecond)
+			_, hasDeadline := ctx.Deadline()
+			detached.Store(hasDeadline && ctx.Err() == nil)
+			return &testClient{}, time.Time{}, nil
+		},
+	}}
+	p.initialise(nil)
+
+	// the caller stops waiting when its context is cancelled, but the client is still created
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := p.GetClient(ctx, &Connection{Name: "c1"}, nil); err == nil {
+		t.Fatal("expected the caller to be cancelled")
+	}
+	client, err := p.GetClient(context.Background(), &Connection{Name: "c1"}, nil)
+	if err != nil || client == nil {
+		t.Fatalf("expected the client to be created, got error %v", err)
+	}
+	if !detached.Load() {
+		t.Error("expected the create context to be detached from the caller context, with a timeout")
+	}
+}
+
+func TestClientRegistryConfigChangedDuringCreate(t *testing.T) {
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	var created []*testClient
+	p := &Plugin{Name: "test", ClientFactory: &ClientFactory{
+		Create: func(ctx context.Context, connection *Connection, matrixItem map[string]any) (any, time.Time, error) {
+			client := &testClient{name: connection.Config.(string)}
+			created = append(created, client)
+			// block the first create until the config has changed
+			if len(created) == 1 {
+				close(started)
+				<-proceed
+			}
+			return client, time.Time{}, nil
+		},
+		Close: func(client any) error {
+			client.(*testClient).closed = true
+			return nil
+		},
+	}}
+	p.initialise(nil)
+	connection := &Connection{Name: "c1", Config: "v1"}
+	p.ConnectionMap["c1"] = &ConnectionData{Connection: connection}
+
+	type result struct {
+		client any
+		err    error
+	}
+	resultChan := make(chan result)
+	go func() {
+		client, err := p.GetClient(context.Background(), connection, nil)
+		resultChan <- result{client, err}
+	}()
+
+	// update the config (in place, as upsertConnectionData does) while the client is being created
+	<-started
+	connection.Config = "v2"
+	p.closeClients("c1")
+	close(proceed)
+
+	res := <-resultChan
+	if res.err != nil {
+		t.Fatalf("unexpected error: %s", res.err.Error())
+	}
+	if len(created) != 2 || !created[0].closed {
+		t.Fatalf("expected the client created using the previous config to be closed, and a new client created")
+	}
+	if client := res.client.(*testClient); client != created[1] || client.name != "v2" || client.closed {
+		t.Errorf("expected the client created using the new config")
+	}
+	// the new client is stored
+	if client, _ := p.GetClient(context.Background(), connection, nil); client != created[1] {
+		t.Errorf("expected the client created using the new config to be stored")
+	}
+
+	// a client cannot be created using a connection with a stale config
+	p.closeClients("c1")
+	if _, err := p.GetClient(context.Background(), &Connection{Name: "c1", Config: "v1"}, nil); err == nil {
+		t.Errorf("expected an error creating a client using a stale connection config")
+	}
+}
@@ -149,12 +149,30 @@ type Column struct {
 	Default interface{}
 	//  a list of transforms to generate the column value
 	Transform *transform.ColumnTransforms
+	// the sensitivity classification of the column (e.g. secret, pii)
+	// - users may define redaction policies for each classification in the connection config
+	Sensitivity ColumnSensitivity
+	// is the column deprecated
+	Deprecated bool
+	// the name of the column which replaces this (deprecated) column
+	// - if the column does not define its own Hydrate or Transform, it is an alias of the replacement column
+	ReplacedBy string
+	// the plugin version in which this (deprecated) column will be removed
+	RemovalVersion string
 
 	namedHydrate namedHydrateFunc
 	Sort         SortOrder
+	// for a deprecated column alias, the replacement column
+	aliasOf *Column
 }
 
 func (c *Column) initialise() {
+	// compile any transform expressions - any errors are reported by validate
+	if c.Transform != nil {
+		if err := c.Transform.Compile(); err != nil {
+			log.Printf("[WARN] column %s: %s", c.Name, err.Error())
+		}
+	}
 	if c.Hydrate == nil {
 		return
 	}
@@ -293,7 +311,12 @@ func (c *Column) validate(t *Table) []string {
 	if c.Hydrate != nil && isMemoized(c.Hydrate) {
 		return []string{fmt.Sprintf("table '%s' column '%s' is using a memoized hydrate function\n This is not supported. To use a memoized hydrate function for a column hydrate call, wrap the memoized function inside another hydrate function", t.Name, c.Name)}
 	}
-	return nil
+	if c.Transform != nil {
+		if err := c.Transform.Compile(); err != nil {
+			return []string{fmt.Sprintf("table '%s' column '%s' has an invalid transform: %s", t.Name, c.Name, err.Error())}
+		}
+	}
+	return c.validateDeprecation(t)
 }
 
 // QueryColumn is struct storing column name and resolved hydrate name (including List/Get call)
@@ -303,8 +326,13 @@ type QueryColumn struct {
 	// the name of the hydrate function which will be used to populate this column
 	// - this may be a default hydrate function
 	hydrateName string
+	// the resolved transforms for this column - either the column transforms or the default transform
+	// (this is resolved once per query rather than for every row)
+	transforms *transform.ColumnTransforms
+	// the redaction policy for this column, defined by the connection config
+	redaction redactionPolicy
 }
 
 func NewQueryColumn(column *Column, hydrateName string) *QueryColumn {
-	return &QueryColumn{column, hydrateName}
+	return &QueryColumn{Column: column, hydrateName: hydrateName, redaction: redactionNone}
 }
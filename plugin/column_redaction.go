@@ -0,0 +1,330 @@
+package plugin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/sperr"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+/*
+ColumnSensitivity is the sensitivity classification of a column, set using [plugin.Column.Sensitivity].
+
+Users may define a redaction policy for each classification in the connection config (see [connectionRedaction]).
+
+Plugins may use the predefined classifications, or define their own.
+*/
+type ColumnSensitivity string
+
+const (
+	// SensitivitySecret is used for secrets and credentials, e.g. access keys, passwords and tokens
+	SensitivitySecret ColumnSensitivity = "secret"
+	// SensitivityPII is used for personally identifiable information, e.g. email addresses and phone numbers
+	SensitivityPII ColumnSensitivity = "pii"
+)
+
+type redactionPolicy string
+
+const (
+	// the column value is not redacted
+	redactionNone redactionPolicy = "none"
+	// the column value is set to null
+	redactionNull redactionPolicy = "null"
+	// the column value is replaced by its HMAC-SHA256 hash (or SHA-256 hash if there is no hash key)
+	redactionHash redactionPolicy = "hash"
+	// all but the end of the column value is masked
+	redactionPartial redactionPolicy = "partial"
+	// the column is removed from the connection schema
+	redactionDrop redactionPolicy = "drop"
+)
+
+var redactionPolicies = []redactionPolicy{redactionNone, redactionNull, redactionHash, redactionPartial, redactionDrop}
+
+const (
+	redactionMask = "****"
+	// the number of characters left unmasked by the partial redaction policy
+	partialRedactionVisibleChars = 4
+	// values shorter than this are fully masked by the partial redaction policy
+	partialRedactionMinLength = 12
+)
+
+/*
+connectionRedaction contains the user defined column redaction policies, parsed from the connection config.
+
+Users may define how to redact the values of sensitive columns in the connection config, keyed either by
+the column sensitivity classification (see [plugin.Column.Sensitivity]), or by a specific column, in the form '<table>.<column>':
+
+	connection "aws" {
+		plugin          = "aws"
+		redact_hash_key = env("STEAMPIPE_REDACT_HASH_KEY")
+		redact = {
+			secret              = "drop"
+			pii                 = "partial"
+			"aws_iam_user.name" = "hash"
+		}
+	}
+
+The policies are:
+  - null: the column value is set to null
+  - hash: the column value is replaced by its HMAC-SHA256 hash, keyed using redact_hash_key, so values can still be compared and joined
+  - partial: the column value is masked, except for the last 4 characters (or the first character and the domain of an email address)
+  - drop: the column is removed from the connection schema
+  - none: the column is not redacted (used to override the policy of the column classification)
+
+A column specific policy takes precedence over the policy for the column classification.
+
+If redact_hash_key is not set, the hash policy uses an unkeyed SHA-256 hash. This is NOT a confidentiality control -
+values with low entropy (e.g. email addresses and phone numbers) can be recovered by hashing candidate values.
+Set redact_hash_key (ideally using the env or secret functions) so the hashes can only be reproduced using the key.
+
+Redaction is applied when the column values are built, so redacted values are never streamed or written to the query cache.
+The hash and partial policies can only be applied to string and JSON columns - the values of other columns are set to null.
+
+Redacted columns are marked in the connection schema, using [proto.ColumnDefinition.Redaction].
+*/
+type connectionRedaction struct {
+	// policies keyed by column sensitivity classification
+	sensitivityPolicies map[ColumnSensitivity]redactionPolicy
+	// policies keyed by '<table>.<column>'
+	columnPolicies map[string]redactionPolicy
+	// the key used for the HMAC of hashed columns (if empty, an unkeyed SHA-256 hash is used)
+	hashKey []byte
+}
+
+func (r *connectionRedaction) empty() bool {
+	return r == nil || (len(r.sensitivityPolicies) == 0 && len(r.columnPolicies) == 0)
+}
+
+func (r *connectionRedaction) equals(other *connectionRedaction) bool {
+	if r.empty() || other.empty() {
+		return r.empty() && other.empty()
+	}
+	return maps.Equal(r.sensitivityPolicies, other.sensitivityPolicies) &&
+		maps.Equal(r.columnPolicies, other.columnPolicies) &&
+		hmac.Equal(r.hashKey, other.hashKey)
+}
+
+// getHashKey returns the key used for the HMAC of hashed columns (nil if there is none)
+func (r *connectionRedaction) getHashKey() []byte {
+	if r == nil {
+		return nil
+	}
+	return r.hashKey
+}
+
+// policyForColumn returns the redaction policy for the given table column
+func (r *connectionRedaction) policyForColumn(tableName string, column *Column) redactionPolicy {
+	if r.empty() {
+		return redactionNone
+	}
+	if policy, ok := r.columnPolicies[tableName+"."+column.Name]; ok {
+		return policy
+	}
+	if column.Sensitivity != "" {
+		if policy, ok := r.sensitivityPolicies[column.Sensitivity]; ok {
+			return policy
+		}
+	}
+	return redactionNone
+}
+
+// applyToSchema returns a copy of the schema with the redaction policies applied:
+//   - dropped columns are removed
+//   - redacted columns have their Redaction property set, and no longer support sort pushdown
+//     (as the values would be sorted before redaction)
+//
+// if no columns are redacted, the schema is returned unchanged
+func (r *connectionRedaction) applyToSchema(schema *grpc.PluginSchema, tableMap map[string]*Table) *grpc.PluginSchema {
+	if r.empty() || schema == nil {
+		return schema
+	}
+	res := &grpc.PluginSchema{
+		Schema:       make(map[string]*proto.TableSchema, len(schema.Schema)),
+		Mode:         schema.Mode,
+		RateLimiters: schema.RateLimiters,
+	}
+	for tableName, tableSchema := range schema.Schema {
+		res.Schema[tableName] = tableSchema
+		table, ok := tableMap[tableName]
+		if !ok {
+			continue
+		}
+		var redactedSchema *proto.TableSchema
+		for i := len(tableSchema.Columns) - 1; i >= 0; i-- {
+			column := table.getColumn(tableSchema.Columns[i].Name)
+			if column == nil {
+				continue
+			}
+			policy := r.policyForColumn(tableName, column)
+			if policy == redactionNone {
+				continue
+			}
+			// copy the table schema before modifying
+			if redactedSchema == nil {
+				redactedSchema = protobuf.Clone(tableSchema).(*proto.TableSchema)
+			}
+			if policy == redactionDrop {
+				redactedSchema.Columns = slices.Delete(redactedSchema.Columns, i, i+1)
+				continue
+			}
+			redactedSchema.Columns[i].Redaction = string(policy)
+			redactedSchema.Columns[i].SortOrder = proto.SortOrder_None
+		}
+		if redactedSchema != nil {
+			res.Schema[tableName] = redactedSchema
+		}
+	}
+	return res
+}
+
+// redactColumnValue returns the column value with the redaction policy applied
+// hashKey is the key used for the HMAC of hashed values (if empty, an unkeyed SHA-256 hash is used)
+func redactColumnValue(policy redactionPolicy, value *proto.Column, hashKey []byte) *proto.Column {
+	if policy == "" || policy == redactionNone || value == nil {
+		return value
+	}
+	// there is nothing to redact in a null value
+	if _, isNull := value.Value.(*proto.Column_NullValue); isNull {
+		return value
+	}
+	switch policy {
+	case redactionHash:
+		switch v := value.Value.(type) {
+		case *proto.Column_StringValue:
+			return &proto.Column{Value: &proto.Column_StringValue{StringValue: hashRedact([]byte(v.StringValue), hashKey)}}
+		case *proto.Column_JsonValue:
+			return &proto.Column{Value: &proto.Column_JsonValue{JsonValue: redactedJsonString(hashRedact(v.JsonValue, hashKey))}}
+		}
+	case redactionPartial:
+		switch v := value.Value.(type) {
+		case *proto.Column_StringValue:
+			return &proto.Column{Value: &proto.Column_StringValue{StringValue: partialRedact(v.StringValue)}}
+		case *proto.Column_JsonValue:
+			// only JSON string values can be partially masked
+			var s string
+			if json.Unmarshal(v.JsonValue, &s) == nil {
+				return &proto.Column{Value: &proto.Column_JsonValue{JsonValue: redactedJsonString(partialRedact(s))}}
+			}
+		}
+	}
+	// otherwise (null policy or a column type which cannot be hashed or masked) return null
+	return &proto.Column{Value: &proto.Column_NullValue{}}
+}
+
+// hashRedact returns the HMAC-SHA256 of the value using the hash key
+// if there is no hash key, the (unkeyed) SHA-256 hash is returned
+func hashRedact(value []byte, hashKey []byte) string {
+	if len(hashKey) == 0 {
+		hash := sha256.Sum256(value)
+		return "sha256:" + hex.EncodeToString(hash[:])
+	}
+	mac := hmac.New(sha256.New, hashKey)
+	mac.Write(value)
+	return "hmac-sha256:" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// partialRedact masks all but the last 4 characters of the value
+// for an email address, the first character and the domain are left unmasked
+// NOTE: the mask does not preserve the length of the value
+func partialRedact(value string) string {
+	if local, domain, ok := strings.Cut(value, "@"); ok && len(local) > 0 && len(domain) > 0 {
+		return string([]rune(local)[:1]) + redactionMask + "@" + domain
+	}
+	runes := []rune(value)
+	if len(runes) < partialRedactionMinLength {
+		return redactionMask
+	}
+	return redactionMask + string(runes[len(runes)-partialRedactionVisibleChars:])
+}
+
+func redactedJsonString(s string) []byte {
+	// marshalling a string cannot fail
+	res, _ := json.Marshal(s)
+	return res
+}
+
+// return the redaction policies for the query connection (if any)
+func getConnectionRedaction(d *QueryData) *connectionRedaction {
+	if d == nil || d.Connection == nil {
+		return nil
+	}
+	return d.Connection.redaction
+}
+
+// parseConnectionRedaction parses the redaction policies from the SDK connection config content
+func parseConnectionRedaction(content *hcl.BodyContent, connectionName string, evalCtx *hcl.EvalContext) (*connectionRedaction, error) {
+	attr, ok := content.Attributes[connectionConfigRedact]
+	if !ok {
+		if _, ok := content.Attributes[connectionConfigRedactKey]; ok {
+			log.Printf("[WARN] connection '%s' sets '%s' but does not define any redaction policies", connectionName, connectionConfigRedactKey)
+		}
+		return nil, nil
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, DiagsToError(fmt.Sprintf("failed to parse '%s'", connectionConfigRedact), diags)
+	}
+	var raw map[string]string
+	mapVal, err := convert.Convert(val, cty.Map(cty.String))
+	if err != nil || gocty.FromCtyValue(mapVal, &raw) != nil {
+		return nil, sperr.New("'%s' must be a map of sensitivity classification or '<table>.<column>' to redaction policy", connectionConfigRedact)
+	}
+
+	res := &connectionRedaction{
+		sensitivityPolicies: make(map[ColumnSensitivity]redactionPolicy),
+		columnPolicies:      make(map[string]redactionPolicy),
+	}
+	for key, p := range raw {
+		policy := redactionPolicy(p)
+		if !slices.Contains(redactionPolicies, policy) {
+			return nil, sperr.New("invalid %s policy '%s' for '%s' - must be one of: %s", connectionConfigRedact, p, key, redactionPolicyNames())
+		}
+		if strings.Contains(key, ".") {
+			res.columnPolicies[key] = policy
+		} else {
+			res.sensitivityPolicies[ColumnSensitivity(key)] = policy
+		}
+	}
+	if res.empty() {
+		return nil, nil
+	}
+	if keyAttr, ok := content.Attributes[connectionConfigRedactKey]; ok {
+		keyVal, diags := keyAttr.Expr.Value(evalCtx)
+		if diags.HasErrors() {
+			return nil, DiagsToError(fmt.Sprintf("failed to parse '%s'", connectionConfigRedactKey), diags)
+		}
+		// NOTE: do not include the value in the error, as it is a secret
+		if keyVal.IsNull() || !keyVal.IsKnown() || keyVal.Type() != cty.String || keyVal.AsString() == "" {
+			return nil, sperr.New("'%s' must be a non-empty string", connectionConfigRedactKey)
+		}
+		res.hashKey = []byte(keyVal.AsString())
+	}
+	log.Printf("[INFO] connection '%s' defines %d column redaction policies", connectionName, len(raw))
+	return res, nil
+}
+
+func redactionPolicyNames() string {
+	return strings.Join(redactionPolicyNameList(), ", ")
+}
+
+func redactionPolicyNameList() []string {
+	names := make([]string, len(redactionPolicies))
+	for i, p := range redactionPolicies {
+		names[i] = string(p)
+	}
+	return names
+}
@@ -0,0 +1,183 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"golang.org/x/exp/maps"
+)
+
+/*
+ConnectionCheckFunc is a function type which verifies a connection, for example by making a lightweight API call
+to check the connection credentials are valid.
+
+It is used to implement [plugin.Plugin.ConnectionCheckFunc], which is called by the TestConnection GRPC call.
+The check is cancelled if it does not complete within [plugin.Plugin.ConnectionCheckTimeout].
+
+Usage:
+
+	func checkConnection(ctx context.Context, p *plugin.Plugin, connection *plugin.Connection) error {
+		client, err := p.GetClient(ctx, connection, nil)
+		if err != nil {
+			return err
+		}
+		_, err = client.(*sts.Client).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		return err
+	}
+*/
+type ConnectionCheckFunc func(ctx context.Context, p *Plugin, connection *Connection) error
+
+const (
+	defaultConnectionCheckTimeout = 30 * time.Second
+	// the maximum number of connection checks to run concurrently
+	maxConcurrentConnectionChecks = 10
+)
+
+// testConnections runs the ConnectionCheckFunc concurrently for the given connections (or all connections if none are given)
+// and returns the results, keyed by connection name.
+// Aggregator connections are tested by testing each of their child connections.
+//
+// This is the handler function for the testConnection GRPC function.
+func (p *Plugin) testConnections(ctx context.Context, connectionNames []string, timeout time.Duration) map[string]*proto.ConnectionCheckResult {
+	log.Printf("[INFO] testConnections %v", connectionNames)
+	if timeout <= 0 {
+		timeout = p.ConnectionCheckTimeout
+	}
+	if timeout <= 0 {
+		timeout = defaultConnectionCheckTimeout
+	}
+	ctx = context.WithValue(ctx, context_key.Logger, p.Logger)
+
+	connectionNames = p.connectionsToTest(connectionNames)
+	results := make(map[string]*proto.ConnectionCheckResult, len(connectionNames))
+	var resultsLock sync.Mutex
+	var wg sync.WaitGroup
+	// limit the number of concurrent checks (a plugin may have a large number of connections)
+	sem := make(chan struct{}, maxConcurrentConnectionChecks)
+	for _, connectionName := range connectionNames {
+		wg.Add(1)
+		go func(connectionName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			res := p.testConnection(ctx, connectionName, timeout)
+			resultsLock.Lock()
+			results[connectionName] = res
+			resultsLock.Unlock()
+		}(connectionName)
+	}
+	wg.Wait()
+	return results
+}
+
+// connectionsToTest returns the connections to test - all connections if none are given,
+// with aggregator connections replaced by their child connections
+func (p *Plugin) connectionsToTest(connectionNames []string) []string {
+	p.connectionMapLock.RLock()
+	defer p.connectionMapLock.RUnlock()
+
+	if len(connectionNames) == 0 {
+		// include connections whose config failed to load
+		connectionNames = append(maps.Keys(p.ConnectionMap), maps.Keys(p.connectionLoadErrors)...)
+	}
+	var res []string
+	for _, connectionName := range connectionNames {
+		if connectionData, ok := p.ConnectionMap[connectionName]; ok && connectionData.isAggregator() {
+			res = append(res, connectionData.config.ChildConnections...)
+			continue
+		}
+		res = append(res, connectionName)
+	}
+	return helpers.StringSliceDistinct(res)
+}
+
+// testConnection runs the ConnectionCheckFunc for a single connection
+func (p *Plugin) testConnection(ctx context.Context, connectionName string, timeout time.Duration) (res *proto.ConnectionCheckResult) {
+	// if the connection config failed to load, report the load error
+	// (if this was a config change, the connection data is for the previous config)
+	if err := p.getConnectionLoadError(connectionName); err != nil {
+		return &proto.ConnectionCheckResult{
+			Status: proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED,
+			Error:  &proto.ConnectionCheckError{Message: fmt.Sprintf("connection '%s' config failed to load: %s", connectionName, err.Error())},
+		}
+	}
+	connectionData, ok := p.getConnectionData(connectionName)
+	if !ok {
+		return &proto.ConnectionCheckResult{
+			Status: proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_FOUND,
+			Error:  &proto.ConnectionCheckError{Message: fmt.Sprintf("connection '%s' not found", connectionName)},
+		}
+	}
+	if p.ConnectionCheckFunc == nil {
+		return &proto.ConnectionCheckResult{
+			Status: proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_SUPPORTED,
+			Error:  &proto.ConnectionCheckError{Message: fmt.Sprintf("plugin '%s' does not define a ConnectionCheckFunc", p.Name)},
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := p.runConnectionCheck(ctx, connectionData.Connection)
+	res = &proto.ConnectionCheckResult{
+		Status:    proto.ConnectionCheckStatus_CONNECTION_CHECK_OK,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err == nil {
+		log.Printf("[INFO] connection check for connection '%s' succeeded (%dms)", connectionName, res.LatencyMs)
+		return res
+	}
+
+	log.Printf("[WARN] connection check for connection '%s' failed (%dms): %s", connectionName, res.LatencyMs, err.Error())
+	res.Status = proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED
+	if errors.Is(err, context.DeadlineExceeded) {
+		res.Status = proto.ConnectionCheckStatus_CONNECTION_CHECK_TIMEOUT
+		err = fmt.Errorf("connection check timed out after %s: %w", timeout, err)
+	}
+	res.Error = newConnectionCheckError(ctx, err)
+	return res
+}
+
+// runConnectionCheck calls the ConnectionCheckFunc, returning an error if it panics,
+// or if it does not return before the context is cancelled
+func (p *Plugin) runConnectionCheck(ctx context.Context, connection *Connection) error {
+	errChan := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				errChan <- fmt.Errorf("connection check panicked: %s", helpers.ToError(r).Error())
+			}
+		}()
+		errChan <- p.ConnectionCheckFunc(ctx, p, connection)
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newConnectionCheckError builds the structured error of a failed check,
+// using the same error properties as the connection error rules
+func newConnectionCheckError(ctx context.Context, err error) *proto.ConnectionCheckError {
+	values := newErrorRuleValues(ctx, nil, "", err)
+	res := &proto.ConnectionCheckError{
+		Message: err.Error(),
+		Code:    values[errorRulePropertyCode],
+	}
+	if httpStatus, err := strconv.Atoi(values[errorRulePropertyHttpStatus]); err == nil {
+		res.HttpStatus = int32(httpStatus)
+	}
+	return res
+}
@@ -0,0 +1,141 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+type testConnectionCheckError struct{}
+
+func (testConnectionCheckError) Error() string       { return "access denied" }
+func (testConnectionCheckError) ErrorCode() string   { return "AccessDenied" }
+func (testConnectionCheckError) HTTPStatusCode() int { return 403 }
+
+func newConnectionCheckTestPlugin(check ConnectionCheckFunc) *Plugin {
+	p := &Plugin{Name: "test", ConnectionCheckFunc: check}
+	p.initialise(nil)
+	for _, config := range []*proto.ConnectionConfig{
+		{Connection: "ok"},
+		{Connection: "denied"},
+		{Connection: "slow"},
+		{Connection: "panic"},
+		{Connection: "all", Type: "aggregator", ChildConnections: []string{"ok", "denied"}},
+	} {
+		p.setConnectionData(NewConnectionData(&Connection{Name: config.Connection}, p, config), config.Connection)
+	}
+	return p
+}
+
+func testConnectionCheck(ctx context.Context, p *Plugin, connection *Connection) error {
+	switch connection.Name {
+	case "denied":
+		return fmt.Errorf("failed to get caller identity: %w", testConnectionCheckError{})
+	case "slow":
+		<-ctx.Done()
+		return ctx.Err()
+	case "panic":
+		panic("unexpected")
+	}
+	return nil
+}
+
+func TestTestConnections(t *testing.T) {
+	p := newConnectionCheckTestPlugin(testConnectionCheck)
+
+	results := p.testConnections(context.Background(), []string{"ok", "denied", "slow", "panic", "missing"}, 50*time.Millisecond)
+	expectedStatus := map[string]proto.ConnectionCheckStatus{
+		"ok":      proto.ConnectionCheckStatus_CONNECTION_CHECK_OK,
+		"denied":  proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED,
+		"slow":    proto.ConnectionCheckStatus_CONNECTION_CHECK_TIMEOUT,
+		"panic":   proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED,
+		"missing": proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_FOUND,
+	}
+	if len(results) != len(expectedStatus) {
+		t.Fatalf("expected %d results, got %d", len(expectedStatus), len(results))
+	}
+	for connectionName, status := range expectedStatus {
+		res := results[connectionName]
+		if res.Status != status {
+			t.Errorf("connection %s: expected status %s, got %s", connectionName, status, res.Status)
+		}
+		if (status == proto.ConnectionCheckStatus_CONNECTION_CHECK_OK) != (res.Error == nil) {
+			t.Errorf("connection %s: expected an error only if the check did not succeed, got %v", connectionName, res.Error)
+		}
+	}
+
+	denied := results["denied"].Error
+	if denied.Code != "AccessDenied" || denied.HttpStatus != 403 || denied.Message != "failed to get caller identity: access denied" {
+		t.Errorf("expected a structured AccessDenied error, got %v", denied)
+	}
+	if slow := results["slow"]; slow.LatencyMs < 50 {
+		t.Errorf("expected the slow check latency to be at least the timeout, got %dms", slow.LatencyMs)
+	}
+}
+
+func TestTestConnectionsAggregator(t *testing.T) {
+	p := newConnectionCheckTestPlugin(testConnectionCheck)
+
+	// an aggregator is tested by testing its child connections
+	results := p.testConnections(context.Background(), []string{"all", "ok"}, 0)
+	if len(results) != 2 || results["ok"] == nil || results["denied"] == nil {
+		t.Fatalf("expected results for the aggregator child connections, got %v", results)
+	}
+
+	// if no connections are given, all connections are tested
+	if results := p.testConnections(context.Background(), nil, 50*time.Millisecond); len(results) != 4 {
+		t.Errorf("expected 4 results, got %d", len(results))
+	}
+}
+
+func TestTestConnectionsNotSupported(t *testing.T) {
+	p := newConnectionCheckTestPlugin(nil)
+
+	results := p.testConnections(context.Background(), []string{"ok"}, 0)
+	if status := results["ok"].Status; status != proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_SUPPORTED {
+		t.Errorf("expected status %s, got %s", proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_SUPPORTED, status)
+	}
+}
+
+func TestTestConnectionsConfigLoadFailed(t *testing.T) {
+	p := newConnectionCheckTestPlugin(testConnectionCheck)
+	p.ConnectionConfigSchema = credentialsSchema()
+	updateData := NewConnectionUpdateData()
+	p.upsertConnections([]*proto.ConnectionConfig{{Connection: "broken", Config: `token = `}}, updateData)
+	if updateData.failedConnections["broken"] == nil {
+		t.Fatal("expected the connection config to fail to load")
+	}
+
+	// the load error is reported, rather than the connection not being found
+	// (and connections which failed to load are tested if no connections are given)
+	results := p.testConnections(context.Background(), nil, 50*time.Millisecond)
+	res, ok := results["broken"]
+	if !ok {
+		t.Fatalf("expected a result for the connection which failed to load, got %v", results)
+	}
+	if res.Status != proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED || res.Error == nil || !strings.Contains(res.Error.Message, "failed to load") {
+		t.Errorf("expected the config load error to be reported, got %s: %v", res.Status, res.Error)
+	}
+
+	// deleting the connection clears the load error
+	p.deleteConnections([]*proto.ConnectionConfig{{Connection: "broken"}})
+	if status := p.testConnections(context.Background(), []string{"broken"}, 0)["broken"].Status; status != proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_FOUND {
+		t.Errorf("expected status %s, got %s", proto.ConnectionCheckStatus_CONNECTION_CHECK_NOT_FOUND, status)
+	}
+}
+
+func TestTestConnectionsCancelled(t *testing.T) {
+	p := newConnectionCheckTestPlugin(testConnectionCheck)
+
+	// the checks use the request context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := p.testConnections(ctx, []string{"slow"}, time.Minute)
+	if res := results["slow"]; res.Status != proto.ConnectionCheckStatus_CONNECTION_CHECK_FAILED || res.LatencyMs >= time.Minute.Milliseconds() {
+		t.Errorf("expected the cancelled check to fail, got %s", res.Status)
+	}
+}
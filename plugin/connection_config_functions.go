@@ -0,0 +1,138 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+/*
+SecretProvider resolves secret references in connection config.
+
+Secrets are referenced using the secret function, e.g.
+
+	connection "aws" {
+		plugin     = "aws"
+		secret_key = secret("vault://aws/prod/secret_key")
+	}
+
+The reference scheme ("vault") selects the provider from [plugin.ConnectionConfigSchema.SecretProviders],
+and the remainder ("aws/prod/secret_key") is passed to GetSecret as the path.
+
+GetSecret is called with a context which times out after secretResolveTimeout.
+
+NOTE: resolved secret values must never be logged.
+*/
+type SecretProvider interface {
+	GetSecret(ctx context.Context, path string) (string, error)
+}
+
+// secretResolveTimeout is the maximum time to wait for a SecretProvider to resolve a secret reference
+const secretResolveTimeout = 30 * time.Second
+
+// SecretProviderFunc is an adapter which allows a function to be used as a [plugin.SecretProvider].
+type SecretProviderFunc func(ctx context.Context, path string) (string, error)
+
+// GetSecret calls f(ctx, path).
+func (f SecretProviderFunc) GetSecret(ctx context.Context, path string) (string, error) {
+	return f(ctx, path)
+}
+
+// evalContext returns the evaluation context used to decode connection config.
+//
+// This provides the functions:
+//   - env("NAME") - the value of an environment variable
+//   - file("path") - the contents of a file
+//   - secret("provider://path") - a secret resolved by one of the SecretProviders
+//
+// The functions are resolved at parse time, so the plugin only ever sees the resolved values.
+func (c *ConnectionConfigSchema) evalContext() *hcl.EvalContext {
+	return &hcl.EvalContext{
+		Variables: make(map[string]cty.Value),
+		Functions: map[string]function.Function{
+			"env":    envFunc,
+			"file":   fileFunc,
+			"secret": c.secretFunc(),
+		},
+	}
+}
+
+// envFunc returns the value of an environment variable - it is an error if the variable is not set
+var envFunc = function.New(&function.Spec{
+	Params: []function.Parameter{{Name: "name", Type: cty.String}},
+	Type:   function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		name := args[0].AsString()
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return cty.NilVal, fmt.Errorf("environment variable '%s' is not set", name)
+		}
+		return cty.StringVal(value), nil
+	},
+})
+
+// fileFunc returns the contents of a file - a leading '~' is expanded to the home directory
+var fileFunc = function.New(&function.Spec{
+	Params: []function.Parameter{{Name: "path", Type: cty.String}},
+	Type:   function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		path, err := expandHomeDir(args[0].AsString())
+		if err != nil {
+			return cty.NilVal, err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			// do not include the underlying error, to avoid leaking anything about the file contents
+			return cty.NilVal, fmt.Errorf("failed to read file '%s'", path)
+		}
+		return cty.StringVal(string(data)), nil
+	},
+})
+
+// secretFunc returns a function which resolves a secret reference using the SecretProviders
+func (c *ConnectionConfigSchema) secretFunc() function.Function {
+	return function.New(&function.Spec{
+		Params: []function.Parameter{{Name: "ref", Type: cty.String}},
+		Type:   function.StaticReturnType(cty.String),
+		Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+			ref := args[0].AsString()
+			scheme, path, ok := strings.Cut(ref, "://")
+			if !ok || scheme == "" {
+				return cty.NilVal, fmt.Errorf("invalid secret reference '%s' - expected '<provider>://<path>'", ref)
+			}
+			provider, ok := c.SecretProviders[scheme]
+			if !ok {
+				return cty.NilVal, fmt.Errorf("invalid secret reference '%s' - no secret provider is registered for '%s'", ref, scheme)
+			}
+			// only log the reference - never the resolved value
+			log.Printf("[TRACE] resolving connection config secret reference '%s'", ref)
+			// connection config is parsed outside of any request, so bound the call with a timeout
+			ctx, cancel := context.WithTimeout(context.Background(), secretResolveTimeout)
+			defer cancel()
+			value, err := provider.GetSecret(ctx, path)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("failed to resolve secret reference '%s': %s", ref, err.Error())
+			}
+			return cty.StringVal(value), nil
+		},
+	})
+}
+
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to expand '%s': %s", path, err.Error())
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
@@ -0,0 +1,170 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
+)
+
+type credentialsCty struct {
+	Token    string  `cty:"token"`
+	Insecure *bool   `cty:"insecure"`
+	Region   *string `cty:"region"`
+}
+
+type credentialsHcl struct {
+	Token string `hcl:"token"`
+}
+
+func credentialsSchema() *ConnectionConfigSchema {
+	return &ConnectionConfigSchema{
+		NewInstance: func() any { return &credentialsCty{} },
+		Schema: map[string]*schema.Attribute{
+			"token":    {Type: schema.TypeString, Required: true, EnvVar: "TEST_PLUGIN_TOKEN"},
+			"insecure": {Type: schema.TypeBool, EnvVar: "TEST_PLUGIN_INSECURE"},
+			"region":   {Type: schema.TypeString, AllowedValues: []string{"us", "eu"}, EnvVar: "TEST_PLUGIN_REGION"},
+		},
+		SecretProviders: map[string]SecretProvider{
+			"vault": SecretProviderFunc(func(_ context.Context, path string) (string, error) {
+				if path == "prod/token" {
+					return "vault-token", nil
+				}
+				return "", fmt.Errorf("secret not found")
+			}),
+		},
+	}
+}
+
+type connectionConfigFunctionsTest struct {
+	name   string
+	source string
+	env    map[string]string
+	// if set, the config is parsed using the hcl tag struct
+	hclTags bool
+	// the expected config, or the expected error substring
+	expected    any
+	expectedErr string
+}
+
+func TestConnectionConfigFunctions(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	insecure := true
+	region := "eu"
+
+	tests := []connectionConfigFunctionsTest{
+		{
+			name:     "env function",
+			source:   `token = env("TEST_PLUGIN_TOKEN_REF")`,
+			env:      map[string]string{"TEST_PLUGIN_TOKEN_REF": "env-token"},
+			expected: credentialsCty{Token: "env-token"},
+		},
+		{
+			name:        "env function - variable not set",
+			source:      `token = env("TEST_PLUGIN_TOKEN_MISSING")`,
+			expectedErr: "environment variable 'TEST_PLUGIN_TOKEN_MISSING' is not set",
+		},
+		{
+			name:     "file function",
+			source:   fmt.Sprintf(`token = file(%q)`, tokenFile),
+			expected: credentialsCty{Token: "file-token"},
+		},
+		{
+			name:     "secret function",
+			source:   `token = secret("vault://prod/token")`,
+			expected: credentialsCty{Token: "vault-token"},
+		},
+		{
+			name:        "secret function - unknown provider",
+			source:      `token = secret("aws://prod/token")`,
+			expectedErr: "no secret provider is registered for 'aws'",
+		},
+		{
+			name:        "secret function - provider error",
+			source:      `token = secret("vault://dev/token")`,
+			expectedErr: "failed to resolve secret reference 'vault://dev/token': secret not found",
+		},
+		{
+			name:     "secret function - hcl tags",
+			source:   `token = secret("vault://prod/token")`,
+			hclTags:  true,
+			expected: credentialsHcl{Token: "vault-token"},
+		},
+		{
+			name:   "EnvVar fallback",
+			source: ``,
+			env: map[string]string{
+				"TEST_PLUGIN_TOKEN":    "env-var-token",
+				"TEST_PLUGIN_INSECURE": "true",
+				"TEST_PLUGIN_REGION":   "eu",
+			},
+			expected: credentialsCty{Token: "env-var-token", Insecure: &insecure, Region: &region},
+		},
+		{
+			name:     "EnvVar fallback - config takes precedence",
+			source:   `token = "config-token"`,
+			env:      map[string]string{"TEST_PLUGIN_TOKEN": "env-var-token"},
+			expected: credentialsCty{Token: "config-token"},
+		},
+		{
+			name:        "EnvVar fallback - required attribute not set",
+			source:      ``,
+			expectedErr: "a value is required - set the attribute or the TEST_PLUGIN_TOKEN environment variable",
+		},
+		{
+			name:        "EnvVar fallback - invalid value",
+			source:      `token = "config-token"`,
+			env:         map[string]string{"TEST_PLUGIN_INSECURE": "not-a-bool"},
+			expectedErr: "the value of the TEST_PLUGIN_INSECURE environment variable is not a valid TypeBool",
+		},
+		{
+			name:        "EnvVar fallback - value not allowed",
+			source:      `token = "config-token"`,
+			env:         map[string]string{"TEST_PLUGIN_REGION": "ap"},
+			expectedErr: "the value of the TEST_PLUGIN_REGION environment variable must be one of: us, eu",
+		},
+		{
+			// the resolved value is not included in the error
+			name: "env function - value not allowed",
+			source: `token = "config-token"
+region = env("TEST_PLUGIN_REGION_REF")`,
+			env:         map[string]string{"TEST_PLUGIN_REGION_REF": "secret-region"},
+			expectedErr: "invalid value for 'region' - must be one of: us, eu",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+			configSchema := credentialsSchema()
+			if test.hclTags {
+				configSchema.Schema = nil
+				configSchema.NewInstance = func() any { return &credentialsHcl{} }
+			}
+			config, err := configSchema.parse(&proto.ConnectionConfig{Connection: "test", Config: test.source})
+			if test.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", test.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(config, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, config)
+			}
+		})
+	}
+}
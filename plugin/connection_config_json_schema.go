@@ -0,0 +1,238 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// the JSON Schema of the SDK connection config attributes
+var sdkConnectionConfigJSONSchema = map[string]map[string]any{
+	connectionConfigIgnoreErrors: {
+		"type":        "array",
+		"items":       map[string]any{"type": "string"},
+		"description": "Additional errors to ignore, using the rate limiter filter syntax, e.g. \"code = 'AccessDenied'\".",
+	},
+	connectionConfigRetryErrors: {
+		"type":        "array",
+		"items":       map[string]any{"type": "string"},
+		"description": "Additional errors to retry, using the rate limiter filter syntax, e.g. \"code = 'Throttling'\".",
+	},
+	connectionConfigRedact: {
+		"type":                 "object",
+		"additionalProperties": map[string]any{"type": "string", "enum": redactionPolicyNameList()},
+		"description":          "Redaction policies, keyed by column sensitivity classification or '<table>.<column>'.",
+	},
+	connectionConfigRedactKey: {
+		"type":        "string",
+		"description": "The key used to compute the keyed (HMAC-SHA256) hash of columns with the 'hash' redaction policy.",
+	},
+}
+
+/*
+ConnectionConfigJSONSchema returns the connection config of the plugin as a JSON Schema document,
+so editors and linters can validate connection config without loading the plugin.
+
+The schema includes the attributes defined by [plugin.ConnectionConfigSchema] (either using Schema,
+or the hcl tags of the config struct), and the connection config attributes handled by the SDK.
+
+Nested blocks are represented as they are in the HCL JSON syntax - a block is an object, and a repeated block is an array of objects.
+
+NOTE: additional properties are allowed at the top level, as the connection block also contains attributes
+which are handled by the CLI (e.g. plugin).
+*/
+func (p *Plugin) ConnectionConfigJSONSchema() ([]byte, error) {
+	properties, required := p.ConnectionConfigSchema.jsonSchemaProperties()
+	for _, attr := range getSdkConnectionConfigSchema(p.ConnectionConfigSchema).Attributes {
+		properties[attr.Name] = sdkConnectionConfigJSONSchema[attr.Name]
+	}
+
+	res := map[string]any{
+		"$schema":    jsonSchemaDraft,
+		"title":      fmt.Sprintf("%s connection config", p.Name),
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		res["required"] = required
+	}
+	return json.MarshalIndent(res, "", "  ")
+}
+
+// getConnectionConfigSchema returns the connection config JSON Schema
+//
+// This is the handler function for the getConnectionConfigSchema GRPC function.
+func (p *Plugin) getConnectionConfigSchema() (string, error) {
+	res, err := p.ConnectionConfigJSONSchema()
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// jsonSchemaProperties returns the JSON Schema of the plugin defined connection config attributes,
+// and the names of the required attributes
+func (c *ConnectionConfigSchema) jsonSchemaProperties() (map[string]any, []string) {
+	switch {
+	case c == nil:
+		return map[string]any{}, nil
+	case c.Schema != nil:
+		return attributesJSONSchema(c.Schema)
+	case c.NewInstance != nil:
+		return hclStructJSONSchema(reflect.TypeOf(c.NewInstance()))
+	}
+	return map[string]any{}, nil
+}
+
+func attributesJSONSchema(attributes map[string]*schema.Attribute) (map[string]any, []string) {
+	properties := make(map[string]any, len(attributes))
+	var required []string
+	for name, attr := range attributes {
+		properties[name] = attributeJSONSchema(attr)
+		// an attribute with an EnvVar may be set using the environment variable instead
+		if attr.Required && attr.EnvVar == "" {
+			required = append(required, name)
+		}
+	}
+	slices.Sort(required)
+	return properties, required
+}
+
+func attributeJSONSchema(attr *schema.Attribute) map[string]any {
+	res := map[string]any{}
+	if attr == nil {
+		return res
+	}
+	switch attr.Type {
+	case schema.TypeBool:
+		res["type"] = "boolean"
+	case schema.TypeInt:
+		res["type"] = "integer"
+	case schema.TypeFloat:
+		res["type"] = "number"
+	case schema.TypeString:
+		res["type"] = "string"
+		if len(attr.AllowedValues) > 0 {
+			res["enum"] = attr.AllowedValues
+		}
+	case schema.TypeList:
+		res["type"] = "array"
+		res["items"] = attributeJSONSchema(attr.Elem)
+		if attr.IsBlock() && attr.Required {
+			res["minItems"] = 1
+		}
+	case schema.TypeMap:
+		res["type"] = "object"
+		res["additionalProperties"] = attributeJSONSchema(attr.Elem)
+	case schema.TypeObject, schema.TypeBlock:
+		properties, required := attributesJSONSchema(attr.Attributes)
+		res["type"] = "object"
+		res["properties"] = properties
+		res["additionalProperties"] = false
+		if len(required) > 0 {
+			res["required"] = required
+		}
+	}
+	if attr.Description != "" {
+		res["description"] = attr.Description
+	}
+	if attr.Default != nil {
+		res["default"] = attr.Default
+	}
+	return res
+}
+
+// hclStructJSONSchema returns the JSON Schema properties for a config struct which uses hcl tags
+func hclStructJSONSchema(t reflect.Type) (map[string]any, []string) {
+	properties := map[string]any{}
+	var required []string
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return properties, nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("hcl")
+		if tag == "" {
+			continue
+		}
+		name, kind, _ := strings.Cut(tag, ",")
+		if name == "" {
+			continue
+		}
+		switch kind {
+		case "", "attr":
+			properties[name] = goTypeJSONSchema(f.Type)
+			required = append(required, name)
+		case "optional":
+			properties[name] = goTypeJSONSchema(f.Type)
+		case "block":
+			properties[name] = hclBlockJSONSchema(f.Type)
+			// a block is optional if it is a pointer or a slice
+			if f.Type.Kind() != reflect.Ptr && f.Type.Kind() != reflect.Slice {
+				required = append(required, name)
+			}
+		}
+	}
+	slices.Sort(required)
+	return properties, required
+}
+
+func hclBlockJSONSchema(t reflect.Type) map[string]any {
+	if t.Kind() == reflect.Slice {
+		return map[string]any{"type": "array", "items": hclBlockJSONSchema(t.Elem())}
+	}
+	properties, required := hclStructJSONSchema(t)
+	res := map[string]any{"type": "object", "properties": properties, "additionalProperties": false}
+	if len(required) > 0 {
+		res["required"] = required
+	}
+	return res
+}
+
+// goTypeJSONSchema returns the JSON Schema for an attribute value of the given go type
+func goTypeJSONSchema(t reflect.Type) map[string]any {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// a cty.Value may contain any value
+	if t == reflect.TypeOf(cty.Value{}) {
+		return map[string]any{}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": goTypeJSONSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": goTypeJSONSchema(t.Elem())}
+	case reflect.Struct:
+		// object attribute values are decoded using cty tags
+		properties := map[string]any{}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if name, _, _ := strings.Cut(f.Tag.Get("cty"), ","); name != "" {
+				properties[name] = goTypeJSONSchema(f.Type)
+			}
+		}
+		return map[string]any{"type": "object", "properties": properties}
+	}
+	// any value
+	return map[string]any{}
+}
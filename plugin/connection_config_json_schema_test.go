@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type hclBlockConfig struct {
+	RoleArn string  `hcl:"role_arn"`
+	Session *string `hcl:"session,optional"`
+}
+
+type hclStructConfig struct {
+	Region     string            `hcl:"region"`
+	Regions    []string          `hcl:"regions,optional"`
+	Tags       map[string]string `hcl:"tags,optional"`
+	MaxItems   *int              `hcl:"max_items,optional"`
+	AssumeRole *hclBlockConfig   `hcl:"assume_role,block"`
+	Endpoints  []hclBlockConfig  `hcl:"endpoint,block"`
+}
+
+type connectionConfigJSONSchemaTest struct {
+	name   string
+	plugin *Plugin
+	// expected values, keyed by a '/' separated path into the JSON Schema document
+	expected map[string]any
+}
+
+var connectionConfigJSONSchemaTests = []connectionConfigJSONSchemaTest{
+	{
+		name:   "no connection config schema",
+		plugin: &Plugin{Name: "test"},
+		expected: map[string]any{
+			"title":                         "test connection config",
+			"type":                          "object",
+			"properties/ignore_errors/type": "array",
+			"properties/redact/additionalProperties/enum": []any{"none", "null", "hash", "partial", "drop"},
+			"required": nil,
+		},
+	},
+	{
+		name:   "schema attributes",
+		plugin: &Plugin{Name: "test", ConnectionConfigSchema: richTypesConfigSchema()},
+		expected: map[string]any{
+			"properties/regions/type":                            "array",
+			"properties/regions/items/type":                      "string",
+			"properties/regions/default":                         []any{"*"},
+			"properties/tags/additionalProperties/type":          "string",
+			"properties/log_level/enum":                          []any{"debug", "info", "warn"},
+			"properties/log_level/default":                       "info",
+			"properties/proxy/required":                          []any{"host"},
+			"properties/proxy/properties/port/type":              "integer",
+			"properties/proxy/additionalProperties":              false,
+			"properties/assume_role/type":                        "object",
+			"properties/assume_role/required":                    []any{"role_arn"},
+			"properties/assume_role/properties/duration/default": float64(3600),
+			"properties/endpoint/type":                           "array",
+			"properties/endpoint/items/properties/region/enum":   []any{"us", "eu"},
+			"properties/retry_errors/items/type":                 "string",
+			"required":                                           nil,
+		},
+	},
+	{
+		name: "hcl struct",
+		plugin: &Plugin{Name: "test", ConnectionConfigSchema: &ConnectionConfigSchema{
+			NewInstance: func() any { return &hclStructConfig{} },
+		}},
+		expected: map[string]any{
+			"required":                                           []any{"region"},
+			"properties/region/type":                             "string",
+			"properties/regions/items/type":                      "string",
+			"properties/tags/additionalProperties/type":          "string",
+			"properties/max_items/type":                          "integer",
+			"properties/assume_role/type":                        "object",
+			"properties/assume_role/required":                    []any{"role_arn"},
+			"properties/assume_role/properties/session/type":     "string",
+			"properties/endpoint/type":                           "array",
+			"properties/endpoint/items/properties/role_arn/type": "string",
+		},
+	},
+}
+
+func TestConnectionConfigJSONSchema(t *testing.T) {
+	for _, test := range connectionConfigJSONSchemaTests {
+		data, err := test.plugin.ConnectionConfigJSONSchema()
+		if err != nil {
+			t.Errorf("test %s failed with error: %s", test.name, err.Error())
+			continue
+		}
+		var doc map[string]any
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Errorf("test %s returned invalid JSON: %s", test.name, err.Error())
+			continue
+		}
+		if doc["$schema"] != jsonSchemaDraft {
+			t.Errorf("test %s: expected $schema %s, got %v", test.name, jsonSchemaDraft, doc["$schema"])
+		}
+		for path, expected := range test.expected {
+			if actual := jsonPathValue(doc, path); !reflect.DeepEqual(actual, expected) {
+				t.Errorf("test %s: expected %s to be %v, got %v", test.name, path, expected, actual)
+			}
+		}
+	}
+}
+
+// jsonPathValue returns the value at the '/' separated path in the JSON document, or nil if it does not exist
+func jsonPathValue(doc map[string]any, path string) any {
+	var current any = doc
+	for _, key := range strings.Split(path, "/") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = m[key]
+	}
+	return current
+}
@@ -12,8 +12,6 @@ import (
 	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
-	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/function"
 	"github.com/zclconf/go-cty/cty/gocty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -55,6 +53,10 @@ type ConnectionConfigSchema struct {
 	Schema map[string]*schema.Attribute
 	// function which returns an instance of a connection config struct
 	NewInstance ConnectionConfigInstanceFunc
+	// secret providers used to resolve secret("<provider>://<path>") references, keyed by provider
+	SecretProviders map[string]SecretProvider
+	// optional function which validates the parsed config (e.g. to check for mutually exclusive attributes)
+	ValidateConfig ConnectionConfigValidateFunc
 }
 
 /*
@@ -81,18 +83,26 @@ type Connection struct {
 	// the connection config
 	// NOTE: we always pass and store connection config BY VALUE
 	Config any
+
+	// user defined rules for errors to ignore and retry, parsed from the connection config
+	errorRules *connectionErrorRules
+	// user defined column redaction policies, parsed from the connection config
+	redaction *connectionRedaction
 }
 
 func (c Connection) shallowCopy() *Connection {
 	return &Connection{
-		Name:   c.Name,
-		Config: c.Config,
+		Name:       c.Name,
+		Config:     c.Config,
+		errorRules: c.errorRules,
+		redaction:  c.redaction,
 	}
 }
 
 // parse function parses the hcl config string into a connection config struct.
 //
-// The schema and the  struct to parse into are provided by the plugin
+// The schema and the  struct to parse into are provided by the plugin.
+// If the plugin defines a ValidateConfig function, this is called with the parsed struct.
 func (c *ConnectionConfigSchema) parse(config *proto.ConnectionConfig) (_ any, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -101,62 +111,79 @@ func (c *ConnectionConfigSchema) parse(config *proto.ConnectionConfig) (_ any, e
 		}
 	}()
 
+	var configStruct any
+	var body hcl.Body
 	if c.Schema == nil {
-		return c.parseConfigWithHclTags(config)
+		configStruct, body, err = c.parseConfigWithHclTags(config)
+	} else {
+		configStruct, body, err = c.parseConfigWithCtyTags(config)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateConfig(config, body, configStruct); err != nil {
+		return nil, err
+	}
+	return configStruct, nil
+}
+
+// configStartPos returns the start position of the connection config
+// filename and start line may not have been passed (for older versions of CLI)
+func configStartPos(config *proto.ConnectionConfig) hcl.Pos {
+	startPos := hcl.Pos{Line: 1, Column: 1}
+	if config.StartLine > 0 {
+		startPos.Line = int(config.StartLine)
 	}
-	return c.parseConfigWithCtyTags(config)
+	return startPos
 }
 
 // parse for legacy format config struct using cty tags
-func (c *ConnectionConfigSchema) parseConfigWithCtyTags(config *proto.ConnectionConfig) (any, error) {
+// returns the config struct, and the parsed body
+func (c *ConnectionConfigSchema) parseConfigWithCtyTags(config *proto.ConnectionConfig) (any, hcl.Body, error) {
 	configString := []byte(config.Config)
 	configStruct := c.NewInstance()
 	spec := schema.SchemaToObjectSpec(c.Schema)
 
-	// filename and range may not have been passed (for older versions of CLI)
-	filename := ""
-	startPos := hcl.Pos{}
-
-	file, diags := hclsyntax.ParseConfig(configString, filename, startPos)
+	file, diags := hclsyntax.ParseConfig(configString, config.Filename, configStartPos(config))
 	if diags.HasErrors() {
-		return nil, DiagsToError("Failed to parse connection config", diags)
+		return nil, nil, DiagsToError("Failed to parse connection config", diags)
 	}
-	value, diags := hcldec.Decode(file.Body, spec, nil)
+	// NOTE: remove any SDK error rule attributes before decoding
+	value, diags := hcldec.Decode(c.hideSdkConnectionConfig(file.Body), spec, c.evalContext())
 	if diags.HasErrors() {
-		return nil, DiagsToError(fmt.Sprintf("failed to decode connection config for connection '%s'", config.Connection), diags)
+		return nil, nil, DiagsToError(fmt.Sprintf("failed to decode connection config for connection '%s'", config.Connection), diags)
+	}
+	// apply the default values of any attributes which are not set
+	value, err := schema.ApplyDefaults(c.Schema, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to apply connection config defaults for connection '%s': %v", config.Connection, err)
 	}
 
 	// decode into the provided struct
 	if err := gocty.FromCtyValue(value, configStruct); err != nil {
-		return nil, fmt.Errorf("failed to marshal parsed config into config struct for connection '%s': %v", config.Connection, err)
+		return nil, nil, fmt.Errorf("failed to marshal parsed config into config struct for connection '%s': %v", config.Connection, err)
 	}
 	// return the struct by value
-	return helpers.DereferencePointer(configStruct), nil
+	return helpers.DereferencePointer(configStruct), file.Body, nil
 }
 
-func (c *ConnectionConfigSchema) parseConfigWithHclTags(config *proto.ConnectionConfig) (_ any, err error) {
+// returns the config struct, and the parsed body
+func (c *ConnectionConfigSchema) parseConfigWithHclTags(config *proto.ConnectionConfig) (any, hcl.Body, error) {
 	configString := []byte(config.Config)
 	configStruct := c.NewInstance()
-	// filename and range may not have been passed (for older versions of CLI)
-	filename := ""
-	startPos := hcl.Pos{}
 
-	body, diags := parseConfig(configString, filename, startPos)
+	body, diags := parseConfig(configString, config.Filename, configStartPos(config))
 	if diags.HasErrors() {
-		return nil, DiagsToError(fmt.Sprintf("failed to parse connection config for connection '%s'", config.Connection), diags)
+		return nil, nil, DiagsToError(fmt.Sprintf("failed to parse connection config for connection '%s'", config.Connection), diags)
 	}
-	evalCtx := &hcl.EvalContext{
-		Variables: make(map[string]cty.Value),
-		Functions: make(map[string]function.Function),
-	}
-
-	moreDiags := gohcl.DecodeBody(body, evalCtx, configStruct)
+	// NOTE: remove any SDK error rule attributes before decoding
+	moreDiags := gohcl.DecodeBody(c.hideSdkConnectionConfig(body), c.evalContext(), configStruct)
 	diags = append(diags, moreDiags...)
 	if diags.HasErrors() {
-		return nil, DiagsToError(fmt.Sprintf("failed to parse connection config for connection '%s'", config.Connection), diags)
+		return nil, nil, DiagsToError(fmt.Sprintf("failed to parse connection config for connection '%s'", config.Connection), diags)
 	}
 	// return the struct by value
-	return helpers.DereferencePointer(configStruct), nil
+	return helpers.DereferencePointer(configStruct), body, nil
 }
 
 func parseConfig(configString []byte, filename string, startPos hcl.Pos) (hcl.Body, hcl.Diagnostics) {
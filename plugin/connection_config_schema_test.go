@@ -74,6 +74,78 @@ type extraPropertyWithAnnotationCty struct {
 	Count   int      `cty:"count"`
 	Pi      float64  `cty:"pi"`
 }
+type proxyCty struct {
+	Host string `cty:"host"`
+	Port *int   `cty:"port"`
+}
+type assumeRoleCty struct {
+	RoleArn    string  `cty:"role_arn"`
+	ExternalId *string `cty:"external_id"`
+	Duration   int     `cty:"duration"`
+}
+type endpointCty struct {
+	Url    string `cty:"url"`
+	Region string `cty:"region"`
+}
+type richTypesCty struct {
+	Regions    []string          `cty:"regions"`
+	Tags       map[string]string `cty:"tags"`
+	LogLevel   string            `cty:"log_level"`
+	Proxy      *proxyCty         `cty:"proxy"`
+	AssumeRole *assumeRoleCty    `cty:"assume_role"`
+	Endpoints  []endpointCty     `cty:"endpoint"`
+}
+
+func richTypesSchema() map[string]*schema.Attribute {
+	return map[string]*schema.Attribute{
+		"regions": {
+			Type:    schema.TypeList,
+			Elem:    &schema.Attribute{Type: schema.TypeString},
+			Default: []string{"*"},
+		},
+		"tags": {
+			Type: schema.TypeMap,
+			Elem: &schema.Attribute{Type: schema.TypeString},
+		},
+		"log_level": {
+			Type:          schema.TypeString,
+			AllowedValues: []string{"debug", "info", "warn"},
+			Default:       "info",
+		},
+		"proxy": {
+			Type: schema.TypeObject,
+			Attributes: map[string]*schema.Attribute{
+				"host": {Type: schema.TypeString, Required: true},
+				"port": {Type: schema.TypeInt},
+			},
+		},
+		"assume_role": {
+			Type: schema.TypeBlock,
+			Attributes: map[string]*schema.Attribute{
+				"role_arn":    {Type: schema.TypeString, Required: true},
+				"external_id": {Type: schema.TypeString},
+				"duration":    {Type: schema.TypeInt, Default: 3600},
+			},
+		},
+		"endpoint": {
+			Type: schema.TypeList,
+			Elem: &schema.Attribute{
+				Type: schema.TypeBlock,
+				Attributes: map[string]*schema.Attribute{
+					"url":    {Type: schema.TypeString, Required: true},
+					"region": {Type: schema.TypeString, AllowedValues: []string{"us", "eu"}, Default: "us"},
+				},
+			},
+		},
+	}
+}
+
+func richTypesConfigSchema() *ConnectionConfigSchema {
+	return &ConnectionConfigSchema{
+		NewInstance: func() interface{} { return &richTypesCty{} },
+		Schema:      richTypesSchema(),
+	}
+}
 
 // hcl struct versions
 
@@ -228,6 +300,99 @@ var testCasesParseConfig = map[string]parseConfigTest{
 			Pi: 3.14,
 		},
 	},
+	"rich types cty": {
+		source: `
+		regions   = ["us-east-1"]
+		tags      = { env = "prod" }
+		log_level = "debug"
+		proxy     = { host = "proxy.local", port = 8080 }
+		assume_role {
+			role_arn    = "arn:aws:iam::123456789012:role/test"
+			external_id = "abc"
+			duration    = 900
+		}
+		endpoint {
+			url    = "https://eu.example.com"
+			region = "eu"
+		}
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expectedFunc: func(res interface{}) bool {
+			c := res.(richTypesCty)
+			return reflect.DeepEqual(c.Regions, []string{"us-east-1"}) &&
+				c.Tags["env"] == "prod" &&
+				c.LogLevel == "debug" &&
+				c.Proxy.Host == "proxy.local" && *c.Proxy.Port == 8080 &&
+				c.AssumeRole.RoleArn == "arn:aws:iam::123456789012:role/test" && *c.AssumeRole.ExternalId == "abc" && c.AssumeRole.Duration == 900 &&
+				reflect.DeepEqual(c.Endpoints, []endpointCty{{Url: "https://eu.example.com", Region: "eu"}})
+		},
+	},
+	"rich types defaults cty": {
+		source: `
+		proxy = { host = "proxy.local" }
+		assume_role {
+			role_arn = "arn:aws:iam::123456789012:role/test"
+		}
+		endpoint {
+			url = "https://us.example.com"
+		}
+		endpoint {
+			url    = "https://eu.example.com"
+			region = "eu"
+		}
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expectedFunc: func(res interface{}) bool {
+			c := res.(richTypesCty)
+			return reflect.DeepEqual(c.Regions, []string{"*"}) &&
+				c.Tags == nil &&
+				c.LogLevel == "info" &&
+				c.Proxy.Host == "proxy.local" && c.Proxy.Port == nil &&
+				c.AssumeRole.ExternalId == nil && c.AssumeRole.Duration == 3600 &&
+				reflect.DeepEqual(c.Endpoints, []endpointCty{{Url: "https://us.example.com", Region: "us"}, {Url: "https://eu.example.com", Region: "eu"}})
+		},
+	},
+	"rich types no optional blocks cty": {
+		source:                 ``,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expectedFunc: func(res interface{}) bool {
+			c := res.(richTypesCty)
+			return c.AssumeRole == nil && c.Proxy == nil && len(c.Endpoints) == 0 && c.LogLevel == "info"
+		},
+	},
+	"rich types invalid enum cty: EXPECTED ERROR": {
+		source: `
+		log_level = "trace"
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expected:               "ERROR",
+	},
+	"rich types invalid nested enum cty: EXPECTED ERROR": {
+		source: `
+		endpoint {
+			url    = "https://ap.example.com"
+			region = "ap"
+		}
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expected:               "ERROR",
+	},
+	"rich types missing required block attribute cty: EXPECTED ERROR": {
+		source: `
+		assume_role {
+			external_id = "abc"
+		}
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expected:               "ERROR",
+	},
+	"rich types wrong map type cty: EXPECTED ERROR": {
+		source: `
+		tags = ["prod"]
+		`,
+		connectionConfigSchema: richTypesConfigSchema(),
+		expected:               "ERROR",
+	},
 	"all types cty": {
 		source: `
 		regions = ["us-east-1","us-west-2"]
@@ -3,11 +3,13 @@ package plugin
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/turbot/go-kit/helpers"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
+	"golang.org/x/exp/maps"
 )
 
 // Validate validates the connection config
@@ -24,9 +26,14 @@ func (c *ConnectionConfigSchema) Validate() []string {
 	}
 
 	if c.Schema != nil {
-		for name, attr := range c.Schema {
-			if attr.Type != schema.TypeList && attr.Elem != nil {
-				validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has 'Elem' set but is Type is not TypeList", name))
+		names := maps.Keys(c.Schema)
+		slices.Sort(names)
+		for _, name := range names {
+			attr := c.Schema[name]
+			attrErrors := validateConfigAttribute(name, attr, true)
+			validationErrors = append(validationErrors, attrErrors...)
+			if len(attrErrors) > 0 {
+				continue
 			}
 
 			// find a property in the struct which is tagged with this field
@@ -37,6 +44,78 @@ func (c *ConnectionConfigSchema) Validate() []string {
 	return validationErrors
 }
 
+// validateConfigAttribute validates the attribute definition, and any nested attributes
+// path is the attribute name, qualified by the names of any parent attributes
+// blockAllowed is set if the attribute may be a nested block (i.e. it is a top level attribute, or the attribute of a block)
+func validateConfigAttribute(path string, attr *schema.Attribute, blockAllowed bool) []string {
+	if attr == nil {
+		return []string{fmt.Sprintf("attribute %s is nil", path)}
+	}
+	var validationErrors []string
+	switch attr.Type {
+	case schema.TypeBool, schema.TypeInt, schema.TypeFloat, schema.TypeString:
+	case schema.TypeList, schema.TypeMap:
+		switch {
+		case attr.Elem == nil:
+			validationErrors = append(validationErrors, fmt.Sprintf("attribute %s is %s but 'Elem' is not set", path, attr.Type))
+		case attr.Elem.Type == schema.TypeBlock && (attr.Type != schema.TypeList || !blockAllowed):
+			validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has an 'Elem' of TypeBlock - repeated blocks must be a top level or block attribute of TypeList", path))
+		default:
+			// a list of blocks is a repeated nested block, so the block attributes are validated as block attributes
+			validationErrors = append(validationErrors, validateConfigAttribute(path+"[*]", attr.Elem, attr.Elem.Type == schema.TypeBlock)...)
+		}
+	case schema.TypeObject, schema.TypeBlock:
+		if attr.Type == schema.TypeBlock && !blockAllowed {
+			validationErrors = append(validationErrors, fmt.Sprintf("attribute %s is TypeBlock - blocks may only be top level attributes, or attributes of a block", path))
+		}
+		if len(attr.Attributes) == 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("attribute %s is %s but 'Attributes' is not set", path, attr.Type))
+		}
+		names := maps.Keys(attr.Attributes)
+		slices.Sort(names)
+		for _, name := range names {
+			validationErrors = append(validationErrors, validateConfigAttribute(path+"."+name, attr.Attributes[name], attr.Type == schema.TypeBlock)...)
+		}
+	default:
+		return append(validationErrors, fmt.Sprintf("attribute %s has invalid type %s", path, attr.Type))
+	}
+
+	if attr.Elem != nil && attr.Type != schema.TypeList && attr.Type != schema.TypeMap {
+		validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has 'Elem' set but its Type is not TypeList or TypeMap", path))
+	}
+	if attr.Attributes != nil && attr.Type != schema.TypeObject && attr.Type != schema.TypeBlock {
+		validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has 'Attributes' set but its Type is not TypeObject or TypeBlock", path))
+	}
+	if len(attr.AllowedValues) > 0 && attr.Type != schema.TypeString {
+		validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has 'AllowedValues' set but its Type is not TypeString", path))
+	}
+	if attr.EnvVar != "" && attr.Type != schema.TypeBool && attr.Type != schema.TypeInt && attr.Type != schema.TypeFloat && attr.Type != schema.TypeString {
+		validationErrors = append(validationErrors, fmt.Sprintf("attribute %s has 'EnvVar' set but its Type is not TypeBool, TypeInt, TypeFloat or TypeString", path))
+	}
+	// only validate the default if the type is valid
+	if attr.Default != nil && len(validationErrors) == 0 {
+		validationErrors = append(validationErrors, validateConfigAttributeDefault(path, attr)...)
+	}
+	return validationErrors
+}
+
+func validateConfigAttributeDefault(path string, attr *schema.Attribute) []string {
+	if attr.Required {
+		return []string{fmt.Sprintf("attribute %s is required so cannot have a 'Default'", path)}
+	}
+	if attr.Type == schema.TypeObject || attr.IsBlock() {
+		return []string{fmt.Sprintf("attribute %s is %s so cannot have a 'Default' - set defaults for the nested attributes instead", path, attr.Type)}
+	}
+	defaultValue, err := attr.DefaultValue()
+	if err != nil {
+		return []string{fmt.Sprintf("attribute %s has an invalid 'Default' for %s: %s", path, attr.Type, err.Error())}
+	}
+	if len(attr.AllowedValues) > 0 && !slices.Contains(attr.AllowedValues, defaultValue.AsString()) {
+		return []string{fmt.Sprintf("attribute %s 'Default' %s is not one of the 'AllowedValues': %s", path, defaultValue.AsString(), strings.Join(attr.AllowedValues, ", "))}
+	}
+	return nil
+}
+
 // check all fields in the schema have corresponding tagged struct properties
 // check properties for optional fields are nullable
 func (c *ConnectionConfigSchema) validateConfigStruct(property string, attr *schema.Attribute, instance interface{}) []string {
@@ -64,8 +143,8 @@ func (c *ConnectionConfigSchema) validateConfigStruct(property string, attr *sch
 	}
 	if field == nil {
 		validationErrors = append(validationErrors, fmt.Sprintf("No structure field with tagged for property %s", property))
-	} else if !attr.Required && !nullable(field.Type.Kind()) {
-		// if field is optional, the struct property must be nullable
+	} else if !attr.Required && attr.Default == nil && !nullable(field.Type.Kind()) {
+		// if field is optional (and has no default), the struct property must be nullable
 		validationErrors = append(validationErrors, fmt.Sprintf("config structure '%s' is invalid:  optional field '%s' is mapped to %s property '%s' - optional fields must map to a type with a null zero value (struct, array, map or pointer)", t.Name(), property, field.Type.Name(), field.Name))
 	}
 
@@ -73,5 +152,5 @@ func (c *ConnectionConfigSchema) validateConfigStruct(property string, attr *sch
 }
 
 func nullable(kind reflect.Kind) bool {
-	return kind == reflect.Ptr || kind == reflect.Array || kind == reflect.Interface || kind == reflect.Slice
+	return kind == reflect.Ptr || kind == reflect.Array || kind == reflect.Interface || kind == reflect.Slice || kind == reflect.Map
 }
@@ -199,6 +199,67 @@ var testCasesValidateConfig = map[string]validateConfigTest{
 		},
 		expected: []string{"No structure field with tagged for property regions"},
 	},
+	"rich types": {
+		schema:   richTypesConfigSchema(),
+		expected: nil,
+	},
+	"map without elem": {
+		schema: &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &richTypesCty{} },
+			Schema: map[string]*schema.Attribute{
+				"tags": {Type: schema.TypeMap},
+			},
+		},
+		expected: []string{"attribute tags is TypeMap but 'Elem' is not set"},
+	},
+	"block nested in object": {
+		schema: &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &richTypesCty{} },
+			Schema: map[string]*schema.Attribute{
+				"proxy": {
+					Type: schema.TypeObject,
+					Attributes: map[string]*schema.Attribute{
+						"host": {Type: schema.TypeString},
+						"auth": {Type: schema.TypeBlock, Attributes: map[string]*schema.Attribute{"user": {Type: schema.TypeString}}},
+					},
+				},
+			},
+		},
+		expected: []string{"attribute proxy.auth is TypeBlock - blocks may only be top level attributes, or attributes of a block"},
+	},
+	"object without attributes": {
+		schema: &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &richTypesCty{} },
+			Schema: map[string]*schema.Attribute{
+				"proxy": {Type: schema.TypeObject},
+			},
+		},
+		expected: []string{"attribute proxy is TypeObject but 'Attributes' is not set"},
+	},
+	"invalid enum and defaults": {
+		schema: &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &richTypesCty{} },
+			Schema: map[string]*schema.Attribute{
+				"log_level": {Type: schema.TypeString, AllowedValues: []string{"debug", "info"}, Default: "trace"},
+				"regions":   {Type: schema.TypeList, Elem: &schema.Attribute{Type: schema.TypeString}, Required: true, Default: []string{"*"}},
+				"tags":      {Type: schema.TypeMap, Elem: &schema.Attribute{Type: schema.TypeInt, AllowedValues: []string{"1"}}},
+			},
+		},
+		expected: []string{
+			"attribute log_level 'Default' trace is not one of the 'AllowedValues': debug, info",
+			"attribute regions is required so cannot have a 'Default'",
+			"attribute tags[*] has 'AllowedValues' set but its Type is not TypeString",
+		},
+	},
+	"env var on non primitive type": {
+		schema: &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &richTypesCty{} },
+			Schema: map[string]*schema.Attribute{
+				"regions": {Type: schema.TypeList, Elem: &schema.Attribute{Type: schema.TypeString}, EnvVar: "REGIONS"},
+			},
+		},
+		expected: []string{"attribute regions has 'EnvVar' set but its Type is not TypeBool, TypeInt, TypeFloat or TypeString"},
+	},
 }
 
 func TestValidateConnectionConfig(t *testing.T) {
@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/sperr"
+)
+
+/*
+ConnectionConfigValidateFunc is a function type which validates a parsed connection config.
+
+It is used to implement [plugin.ConnectionConfigSchema.ValidateConfig], and is passed the config struct (by value)
+returned by [plugin.ConnectionConfigSchema.NewInstance].
+
+Usage:
+
+	func ValidateConfig(config any) []plugin.ConnectionConfigDiagnostic {
+		c := config.(awsConfig)
+		if c.Profile != nil && c.AccessKey != nil {
+			return []plugin.ConnectionConfigDiagnostic{{
+				Attribute: "access_key",
+				Message:   "'access_key' cannot be set if 'profile' is set",
+			}}
+		}
+		return nil
+	}
+*/
+type ConnectionConfigValidateFunc func(config any) []ConnectionConfigDiagnostic
+
+// ConnectionConfigDiagnostic is a connection config validation error returned by [plugin.ConnectionConfigValidateFunc].
+type ConnectionConfigDiagnostic struct {
+	// the attribute the error relates to, e.g. "region".
+	// Nested attributes are '.' separated, and repeated blocks are indexed, e.g. "endpoint[1].region".
+	// If empty, the error relates to the whole connection config.
+	Attribute string
+	Message   string
+}
+
+// validateConfig calls the ValidateConfig hook (if defined) for the parsed config,
+// and returns an error listing any diagnostics, with the source range of the attribute they relate to
+func (c *ConnectionConfigSchema) validateConfig(config *proto.ConnectionConfig, body hcl.Body, configStruct any) error {
+	if c.ValidateConfig == nil {
+		return nil
+	}
+	diags := c.ValidateConfig(configStruct)
+	if len(diags) == 0 {
+		return nil
+	}
+	log.Printf("[WARN] connection config validation failed for connection '%s': %d errors", config.Connection, len(diags))
+
+	errStrings := make([]string, len(diags))
+	for i, diag := range diags {
+		errStrings[i] = diag.String(body)
+	}
+	return sperr.New("connection config validation failed for connection '%s':\n%s", config.Connection, strings.Join(errStrings, "\n"))
+}
+
+// String returns the diagnostic message, prefixed by the source range of the attribute it relates to (if it can be found in the body)
+func (d ConnectionConfigDiagnostic) String(body hcl.Body) string {
+	msg := d.Message
+	if d.Attribute != "" {
+		msg = fmt.Sprintf("%s: %s", d.Attribute, d.Message)
+	}
+	rng := configAttributeRange(body, d.Attribute)
+	if rng == nil {
+		return msg
+	}
+	if rng.Filename == "" {
+		return fmt.Sprintf("line %d, column %d: %s", rng.Start.Line, rng.Start.Column, msg)
+	}
+	return fmt.Sprintf("%s:%d,%d: %s", rng.Filename, rng.Start.Line, rng.Start.Column, msg)
+}
+
+// configAttributeRange returns the source range of the attribute or block at the given path in the config body.
+// If the path cannot be fully resolved, the range of the closest parent which was found is returned
+// (or nil if none was found).
+func configAttributeRange(body hcl.Body, path string) *hcl.Range {
+	if body == nil || path == "" {
+		return nil
+	}
+	var res *hcl.Range
+	for _, segment := range strings.Split(path, ".") {
+		name, index := parseConfigPathSegment(segment)
+		syntaxBody, ok := body.(*hclsyntax.Body)
+		if !ok {
+			// for JSON config, we can only resolve top level attributes
+			if res == nil {
+				attrs, _ := body.JustAttributes()
+				if attr, ok := attrs[name]; ok {
+					res = attr.Range.Ptr()
+				}
+			}
+			return res
+		}
+		if attr, ok := syntaxBody.Attributes[name]; ok {
+			// nested values of an attribute are resolved to the attribute range
+			return attr.SrcRange.Ptr()
+		}
+		var blocks hclsyntax.Blocks
+		for _, block := range syntaxBody.Blocks {
+			if block.Type == name {
+				blocks = append(blocks, block)
+			}
+		}
+		if index >= len(blocks) {
+			return res
+		}
+		block := blocks[index]
+		res = block.DefRange().Ptr()
+		body = block.Body
+	}
+	return res
+}
+
+// parseConfigPathSegment parses a path segment of the form 'name' or 'name[index]'
+func parseConfigPathSegment(segment string) (string, int) {
+	name, indexStr, ok := strings.Cut(segment, "[")
+	if !ok {
+		return name, 0
+	}
+	index, err := strconv.Atoi(strings.TrimSuffix(indexStr, "]"))
+	if err != nil || index < 0 {
+		return name, 0
+	}
+	return name, index
+}
@@ -0,0 +1,121 @@
+package plugin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+const validateConfigSource = `log_level = "debug"
+proxy = { host = "proxy", port = 8080 }
+assume_role {
+  role_arn = "arn"
+}
+endpoint {
+  url = "a"
+}
+endpoint {
+  url = "b"
+}
+`
+
+type validateConfigHookTest struct {
+	name     string
+	config   *proto.ConnectionConfig
+	diags    []ConnectionConfigDiagnostic
+	expected []string
+}
+
+var validateConfigHookTests = []validateConfigHookTest{
+	{
+		name:   "no diagnostics",
+		config: &proto.ConnectionConfig{Connection: "test", Config: validateConfigSource},
+	},
+	{
+		name:   "attribute ranges",
+		config: &proto.ConnectionConfig{Connection: "test", Config: validateConfigSource},
+		diags: []ConnectionConfigDiagnostic{
+			{Attribute: "log_level", Message: "debug is not allowed"},
+			{Attribute: "proxy.port", Message: "invalid port"},
+			{Attribute: "assume_role", Message: "invalid block"},
+			{Attribute: "assume_role.role_arn", Message: "invalid role"},
+			{Attribute: "endpoint[1].url", Message: "invalid url"},
+			{Attribute: "endpoint[1].region", Message: "region must be set"},
+			{Attribute: "endpoint[2].url", Message: "no such endpoint"},
+			{Attribute: "regions", Message: "regions must be set"},
+			{Message: "invalid config"},
+		},
+		expected: []string{
+			"connection config validation failed for connection 'test':",
+			"line 1, column 1: log_level: debug is not allowed",
+			"line 2, column 1: proxy.port: invalid port",
+			"line 3, column 1: assume_role: invalid block",
+			"line 4, column 3: assume_role.role_arn: invalid role",
+			"line 10, column 3: endpoint[1].url: invalid url",
+			"line 9, column 1: endpoint[1].region: region must be set",
+			"endpoint[2].url: no such endpoint",
+			"regions: regions must be set",
+			"invalid config",
+		},
+	},
+	{
+		name:   "filename and start line",
+		config: &proto.ConnectionConfig{Connection: "test", Config: validateConfigSource, Filename: "aws.spc", StartLine: 10},
+		diags:  []ConnectionConfigDiagnostic{{Attribute: "assume_role.role_arn", Message: "invalid role"}},
+		expected: []string{
+			"connection config validation failed for connection 'test':",
+			"aws.spc:13,3: assume_role.role_arn: invalid role",
+		},
+	},
+}
+
+func TestValidateConfig(t *testing.T) {
+	for _, test := range validateConfigHookTests {
+		configSchema := richTypesConfigSchema()
+		var validatedConfig any
+		configSchema.ValidateConfig = func(config any) []ConnectionConfigDiagnostic {
+			validatedConfig = config
+			return test.diags
+		}
+
+		config, err := configSchema.parse(test.config)
+		if len(test.expected) == 0 {
+			if err != nil {
+				t.Errorf("test %s failed with unexpected error: %s", test.name, err.Error())
+				continue
+			}
+			if !reflect.DeepEqual(config, validatedConfig) {
+				t.Errorf("test %s: expected the parsed config to be validated, got %v", test.name, validatedConfig)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("test %s: expected an error", test.name)
+			continue
+		}
+		if actual := strings.Split(err.Error(), "\n"); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("test %s:\nexpected %s\ngot      %s", test.name, strings.Join(test.expected, "\n         "), strings.Join(actual, "\n         "))
+		}
+	}
+}
+
+func TestValidateConfigHclTags(t *testing.T) {
+	configSchema := &ConnectionConfigSchema{
+		NewInstance: func() any { return &hclStructConfig{} },
+		ValidateConfig: func(config any) []ConnectionConfigDiagnostic {
+			if config.(hclStructConfig).Region == "mars" {
+				return []ConnectionConfigDiagnostic{{Attribute: "region", Message: "invalid region"}}
+			}
+			return nil
+		},
+	}
+	_, err := configSchema.parse(&proto.ConnectionConfig{Connection: "test", Config: "\nregion = \"mars\"\n"})
+	if err == nil || !strings.Contains(err.Error(), "line 2, column 1: region: invalid region") {
+		t.Errorf("expected a validation error with the attribute range, got %v", err)
+	}
+	if _, err := configSchema.parse(&proto.ConnectionConfig{Connection: "test", Config: `region = "us"`}); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+}
@@ -259,6 +259,9 @@ func (d *ConnectionData) buildAggregatorTableSchema(aggregatorConfig *proto.Conn
 		ListCallOptionalKeyColumns: exemplarSchema.ListCallOptionalKeyColumns,
 		GetCallKeyColumnList:       exemplarSchema.GetCallKeyColumnList,
 		ListCallKeyColumnList:      exemplarSchema.ListCallKeyColumnList,
+		Deprecated:                 exemplarSchema.Deprecated,
+		ReplacedBy:                 exemplarSchema.ReplacedBy,
+		RemovalVersion:             exemplarSchema.RemovalVersion,
 	}
 
 	includedColumns := make(map[string]struct{})
@@ -284,9 +287,12 @@ func (d *ConnectionData) buildAggregatorTableSchema(aggregatorConfig *proto.Conn
 				// set the column type to JSON
 				// overwrite 'column'
 				column = &proto.ColumnDefinition{
-					Name:        column.Name,
-					Type:        proto.ColumnType_JSON,
-					Description: column.Description,
+					Name:           column.Name,
+					Type:           proto.ColumnType_JSON,
+					Description:    column.Description,
+					Deprecated:     column.Deprecated,
+					ReplacedBy:     column.ReplacedBy,
+					RemovalVersion: column.RemovalVersion,
 				}
 			}
 
@@ -339,9 +345,12 @@ func (d *ConnectionData) getSchemaDiffBetweenConnections(aggregatorConfig *proto
 	return exemplarSchema, connectionTableDiffs, messages
 }
 
+// setSchema sets the table map and schema for the connection
+// if the connection has redaction policies, these are applied to the schema
+// (the schema may be shared between connections, so it is copied, not modified)
 func (d *ConnectionData) setSchema(tableMap map[string]*Table, schema *grpc.PluginSchema) {
 	d.TableMap = tableMap
-	d.Schema = schema
+	d.Schema = d.Connection.redaction.applyToSchema(schema, tableMap)
 }
 
 func (d *ConnectionData) isAggregator() bool {
@@ -0,0 +1,188 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+	"github.com/turbot/steampipe-plugin-sdk/v5/sperr"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
+	"google.golang.org/grpc/status"
+)
+
+// error properties which may be used in connection config error rules
+const (
+	errorRulePropertyCode       = "code"
+	errorRulePropertyGrpcCode   = "grpc_code"
+	errorRulePropertyMessage    = "message"
+	errorRulePropertyHttpStatus = "http_status"
+	errorRulePropertyTable      = "table"
+	errorRulePropertyHydrate    = "hydrate"
+	errorRulePropertyConnection = "connection"
+	errorRulePropertyTimeout    = "timeout"
+)
+
+/*
+connectionErrorRules contains the user defined error rules, parsed from the connection config.
+
+Users may define which errors to ignore or retry in the connection config, in addition to the
+plugin defined [IgnoreConfig] and [RetryConfig], using the same filter syntax as rate limiter 'where' clauses:
+
+	connection "aws" {
+		plugin        = "aws"
+		ignore_errors = ["code = 'AccessDenied' and table like 'aws_s3_%'"]
+		retry_errors  = ["http_status in ('502', '503')", "message ilike '%throttl%'"]
+	}
+
+Each rule is evaluated against the following properties of the error:
+  - code: the provider error code (if the error implements ErrorCode() string), otherwise the gRPC code
+  - grpc_code: the gRPC status code (if the error is a gRPC status error)
+  - message: the error message
+  - http_status: the HTTP status code (if the error implements HTTPStatusCode() int or StatusCode() int)
+  - table: the table name
+  - hydrate: the name of the hydrate function
+  - connection: the connection name
+  - timeout: 'true' if the error is a hydrate call timeout (see [HydrateTimeoutError])
+  - the matrix item properties (e.g. region)
+
+NOTE: if the plugin connection config schema defines an attribute with the same name, the plugin attribute takes precedence.
+*/
+type connectionErrorRules struct {
+	ignore []*errorRule
+	retry  []*errorRule
+}
+
+func (r *connectionErrorRules) empty() bool {
+	return r == nil || (len(r.ignore) == 0 && len(r.retry) == 0)
+}
+
+func (r *connectionErrorRules) shouldIgnoreError(ctx context.Context, d *QueryData, hydrateName string, err error) bool {
+	if r == nil || len(r.ignore) == 0 {
+		return false
+	}
+	return anyErrorRuleSatisfied(r.ignore, newErrorRuleValues(ctx, d, hydrateName, err))
+}
+
+func (r *connectionErrorRules) shouldRetryError(ctx context.Context, d *QueryData, hydrateName string, err error) bool {
+	if r == nil || len(r.retry) == 0 {
+		return false
+	}
+	return anyErrorRuleSatisfied(r.retry, newErrorRuleValues(ctx, d, hydrateName, err))
+}
+
+type errorRule struct {
+	raw       string
+	satisfied func(map[string]string) bool
+}
+
+func newErrorRule(attribute, raw string) (*errorRule, error) {
+	satisfied, err := rate_limiter.ParseScopeFilter(raw)
+	if err != nil {
+		return nil, sperr.New("invalid %s rule '%s': %s", attribute, raw, err.Error())
+	}
+	return &errorRule{raw: raw, satisfied: satisfied}, nil
+}
+
+func anyErrorRuleSatisfied(rules []*errorRule, values map[string]string) bool {
+	for _, rule := range rules {
+		if rule.satisfied(values) {
+			log.Printf("[TRACE] error satisfies connection config error rule '%s'", rule.raw)
+			return true
+		}
+	}
+	return false
+}
+
+// newErrorRuleValues builds the map of error properties used to evaluate the error rules
+func newErrorRuleValues(ctx context.Context, d *QueryData, hydrateName string, err error) map[string]string {
+	res := map[string]string{
+		errorRulePropertyMessage: err.Error(),
+		errorRulePropertyHydrate: hydrateName,
+		errorRulePropertyTimeout: strconv.FormatBool(IsHydrateTimeoutError(err)),
+	}
+	if d != nil {
+		if d.Table != nil {
+			res[errorRulePropertyTable] = d.Table.Name
+		}
+		if d.Connection != nil {
+			res[errorRulePropertyConnection] = d.Connection.Name
+		}
+		// add the matrix item properties (without overwriting the error properties)
+		for k, v := range getQueryMatrixItem(ctx, d) {
+			if _, ok := res[k]; !ok {
+				res[k] = fmt.Sprintf("%v", v)
+			}
+		}
+	}
+
+	if s, ok := status.FromError(err); ok {
+		res[errorRulePropertyGrpcCode] = s.Code().String()
+		res[errorRulePropertyCode] = s.Code().String()
+	}
+	// provider error codes take precedence over grpc codes
+	var errorCoder interface{ ErrorCode() string }
+	if errors.As(err, &errorCoder) {
+		res[errorRulePropertyCode] = errorCoder.ErrorCode()
+	}
+	var httpStatusCoder interface{ HTTPStatusCode() int }
+	var statusCoder interface{ StatusCode() int }
+	if errors.As(err, &httpStatusCoder) {
+		res[errorRulePropertyHttpStatus] = strconv.Itoa(httpStatusCoder.HTTPStatusCode())
+	} else if errors.As(err, &statusCoder) {
+		res[errorRulePropertyHttpStatus] = strconv.Itoa(statusCoder.StatusCode())
+	}
+	return res
+}
+
+// parseConnectionErrorRules parses the error rules from the SDK connection config content
+func parseConnectionErrorRules(content *hcl.BodyContent, connectionName string) (_ *connectionErrorRules, err error) {
+	res := &connectionErrorRules{}
+	if res.ignore, err = parseErrorRuleAttribute(content, connectionConfigIgnoreErrors); err != nil {
+		return nil, err
+	}
+	if res.retry, err = parseErrorRuleAttribute(content, connectionConfigRetryErrors); err != nil {
+		return nil, err
+	}
+	if res.empty() {
+		return nil, nil
+	}
+	log.Printf("[INFO] connection '%s' defines %d ignore error %s and %d retry error %s", connectionName, len(res.ignore), pluralize.NewClient().Pluralize("rule", len(res.ignore), false), len(res.retry), pluralize.NewClient().Pluralize("rule", len(res.retry), false))
+	return res, nil
+}
+
+func parseErrorRuleAttribute(content *hcl.BodyContent, name string) ([]*errorRule, error) {
+	attr, ok := content.Attributes[name]
+	if !ok {
+		return nil, nil
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, DiagsToError(fmt.Sprintf("failed to parse '%s'", name), diags)
+	}
+	var raw []string
+	// also allow a single string
+	if val.Type() == cty.String {
+		raw = []string{val.AsString()}
+	} else {
+		listVal, err := convert.Convert(val, cty.List(cty.String))
+		if err != nil || gocty.FromCtyValue(listVal, &raw) != nil {
+			return nil, sperr.New("'%s' must be a list of strings", name)
+		}
+	}
+	var res []*errorRule
+	for _, r := range raw {
+		rule, err := newErrorRule(name, r)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rule)
+	}
+	return res, nil
+}
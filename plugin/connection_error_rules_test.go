@@ -0,0 +1,124 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testCodedError struct {
+	code       string
+	httpStatus int
+}
+
+func (e testCodedError) Error() string       { return "api error " + e.code }
+func (e testCodedError) ErrorCode() string   { return e.code }
+func (e testCodedError) HTTPStatusCode() int { return e.httpStatus }
+
+type connectionErrorRulesTest struct {
+	config          string
+	hasPluginConfig bool
+	expectErr       bool
+	err             error
+	hydrate         string
+	ignore          bool
+	retry           bool
+}
+
+var testCasesConnectionErrorRules = map[string]connectionErrorRulesTest{
+	"ignore by code and table": {
+		config:  `ignore_errors = ["code = 'AccessDenied' and table like 'aws_s3_%'"]`,
+		err:     testCodedError{code: "AccessDenied", httpStatus: 403},
+		hydrate: "getBucket",
+		ignore:  true,
+	},
+	"ignore by code - other code": {
+		config:  `ignore_errors = ["code = 'AccessDenied'"]`,
+		err:     testCodedError{code: "NoSuchBucket", httpStatus: 404},
+		hydrate: "getBucket",
+	},
+	"retry by http status": {
+		config:  `retry_errors = ["http_status in ('502', '503')"]`,
+		err:     testCodedError{code: "Unavailable", httpStatus: 503},
+		hydrate: "listBuckets",
+		retry:   true,
+	},
+	"retry by message and hydrate": {
+		config:  `retry_errors = ["message ilike '%THROTTL%' and hydrate = 'listBuckets'"]`,
+		err:     errors.New("request throttled"),
+		hydrate: "listBuckets",
+		retry:   true,
+	},
+	"retry by grpc code": {
+		config:  `retry_errors = "grpc_code = 'Unavailable'"`,
+		err:     status.Error(codes.Unavailable, "unavailable"),
+		hydrate: "listBuckets",
+		retry:   true,
+	},
+	"plugin config and rules": {
+		config:          "regions = [\"us-east-1\"]\nignore_errors = [\"code = 'AccessDenied'\"]",
+		hasPluginConfig: true,
+		err:             testCodedError{code: "AccessDenied"},
+		ignore:          true,
+	},
+	"invalid rule": {
+		config:    `ignore_errors = ["code = "]`,
+		expectErr: true,
+	},
+	"invalid type": {
+		config:    `retry_errors = 1`,
+		expectErr: true,
+	},
+}
+
+func TestConnectionErrorRules(t *testing.T) {
+	for name, test := range testCasesConnectionErrorRules {
+		sdkConfig, hasPluginConfig, err := parseSdkConnectionConfig(&proto.ConnectionConfig{Connection: "c1", Config: test.config}, nil)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("test %s: expected error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %s: parseSdkConnectionConfig failed: %s", name, err)
+			continue
+		}
+		if hasPluginConfig != test.hasPluginConfig {
+			t.Errorf("test %s: expected hasPluginConfig %v, got %v", name, test.hasPluginConfig, hasPluginConfig)
+		}
+
+		d := &QueryData{
+			Table:      &Table{Name: "aws_s3_bucket"},
+			Connection: &Connection{Name: "c1", errorRules: sdkConfig.errorRules},
+		}
+		if ignore := getConnectionErrorRules(d).shouldIgnoreError(context.Background(), d, test.hydrate, test.err); ignore != test.ignore {
+			t.Errorf("test %s: expected ignore %v, got %v", name, test.ignore, ignore)
+		}
+		if retry := getConnectionErrorRules(d).shouldRetryError(context.Background(), d, test.hydrate, test.err); retry != test.retry {
+			t.Errorf("test %s: expected retry %v, got %v", name, test.retry, retry)
+		}
+	}
+}
+
+func TestConnectionErrorRulesPluginAttribute(t *testing.T) {
+	// if the plugin defines an attribute with the same name, it is not parsed as an error rule
+	type config struct {
+		IgnoreErrors []string `hcl:"ignore_errors,optional"`
+	}
+	schema := &ConnectionConfigSchema{NewInstance: func() any { return &config{} }}
+	sdkConfig, hasPluginConfig, err := parseSdkConnectionConfig(&proto.ConnectionConfig{Config: `ignore_errors = ["not a filter"]`}, schema)
+	if err != nil {
+		t.Fatalf("parseSdkConnectionConfig failed: %s", err)
+	}
+	if sdkConfig.errorRules != nil {
+		t.Error("expected no error rules")
+	}
+	if !hasPluginConfig {
+		t.Error("expected hasPluginConfig")
+	}
+}
@@ -0,0 +1,99 @@
+package plugin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+// connection config attributes which are handled by the SDK, rather than the plugin
+const (
+	connectionConfigIgnoreErrors = "ignore_errors"
+	connectionConfigRetryErrors  = "retry_errors"
+	connectionConfigRedact       = "redact"
+	connectionConfigRedactKey    = "redact_hash_key"
+)
+
+var sdkConnectionConfigAttributes = []string{connectionConfigIgnoreErrors, connectionConfigRetryErrors, connectionConfigRedact, connectionConfigRedactKey}
+
+// sdkConnectionConfig contains the connection config which is handled by the SDK
+// (see [connectionErrorRules] and [connectionRedaction])
+type sdkConnectionConfig struct {
+	errorRules *connectionErrorRules
+	redaction  *connectionRedaction
+}
+
+// getSdkConnectionConfigSchema returns the HCL schema for the SDK connection config attributes,
+// excluding any attributes defined by the plugin connection config schema
+func getSdkConnectionConfigSchema(configSchema *ConnectionConfigSchema) *hcl.BodySchema {
+	res := &hcl.BodySchema{}
+	for _, name := range sdkConnectionConfigAttributes {
+		if configSchema != nil && configSchema.definesAttribute(name) {
+			log.Printf("[INFO] plugin connection config defines '%s' - this attribute will not be parsed by the SDK", name)
+			continue
+		}
+		res.Attributes = append(res.Attributes, hcl.AttributeSchema{Name: name})
+	}
+	return res
+}
+
+// hideSdkConnectionConfig returns a body with the SDK connection config attributes removed,
+// so the remaining body can be decoded using the plugin connection config schema
+func (c *ConnectionConfigSchema) hideSdkConnectionConfig(body hcl.Body) hcl.Body {
+	_, remain, _ := body.PartialContent(getSdkConnectionConfigSchema(c))
+	return remain
+}
+
+// definesAttribute returns whether the plugin connection config schema defines the given attribute
+func (c *ConnectionConfigSchema) definesAttribute(name string) bool {
+	if c.Schema != nil {
+		_, ok := c.Schema[name]
+		return ok
+	}
+	if c.NewInstance == nil {
+		return false
+	}
+	bodySchema, _ := gohcl.ImpliedBodySchema(c.NewInstance())
+	for _, a := range bodySchema.Attributes {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSdkConnectionConfig parses the SDK connection config attributes from the connection config
+// it also returns whether the connection config contains any other (plugin defined) config
+func parseSdkConnectionConfig(config *proto.ConnectionConfig, configSchema *ConnectionConfigSchema) (_ *sdkConnectionConfig, hasPluginConfig bool, err error) {
+	res := &sdkConnectionConfig{}
+	if config.Config == "" {
+		return res, false, nil
+	}
+	body, diags := parseConfig([]byte(config.Config), config.Filename, configStartPos(config))
+	if diags.HasErrors() {
+		// the plugin config parse will report the error
+		return res, true, nil
+	}
+	content, remain, diags := body.PartialContent(getSdkConnectionConfigSchema(configSchema))
+	if diags.HasErrors() {
+		return nil, true, DiagsToError(fmt.Sprintf("failed to parse connection config for connection '%s'", config.Connection), diags)
+	}
+	// is there anything else in the config?
+	remainingAttributes, diags := remain.JustAttributes()
+	hasPluginConfig = diags.HasErrors() || len(remainingAttributes) > 0
+
+	if res.errorRules, err = parseConnectionErrorRules(content, config.Connection); err != nil {
+		return nil, hasPluginConfig, err
+	}
+	// NOTE: the redaction hash key may be resolved using the env, file and secret functions
+	if configSchema == nil {
+		configSchema = &ConnectionConfigSchema{}
+	}
+	if res.redaction, err = parseConnectionRedaction(content, config.Connection, configSchema.evalContext()); err != nil {
+		return nil, hasPluginConfig, err
+	}
+	return res, hasPluginConfig, nil
+}
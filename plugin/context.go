@@ -41,6 +41,14 @@ func GetMatrixItem(ctx context.Context) map[string]interface{} {
 	return value.(map[string]interface{})
 }
 
+// getQueryMatrixItem returns the matrix item of the query data, falling back to the matrix item in the context
+func getQueryMatrixItem(ctx context.Context, d *QueryData) map[string]interface{} {
+	if d != nil && d.matrixItem != nil {
+		return d.matrixItem
+	}
+	return GetMatrixItem(ctx)
+}
+
 /*
 IsCancelled returns whether the context has been cancelled.
 
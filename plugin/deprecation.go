@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+/*
+Tables and columns may be deprecated, to allow them to be renamed or replaced without breaking existing queries:
+
+	{
+		Name:           "region_name",
+		Type:           proto.ColumnType_STRING,
+		Deprecated:     true,
+		ReplacedBy:     "region",
+		RemovalVersion: "v2.0.0",
+	}
+
+A deprecated column which sets ReplacedBy, but does not define its own Hydrate or Transform, is an alias of the
+replacement column - its value is populated using the hydrate function and transforms of the replacement column.
+
+Deprecation is carried in the schema ([proto.ColumnDefinition] and [proto.TableSchema]),
+and querying a deprecated table or column adds a warning to the query metadata.
+*/
+
+// deprecationMessage returns the warning message for a deprecated table or column
+func deprecationMessage(itemType, name, replacedBy, removalVersion string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s '%s' is deprecated", itemType, name)
+	if removalVersion != "" {
+		fmt.Fprintf(&sb, " and will be removed in %s", removalVersion)
+	}
+	if replacedBy != "" {
+		fmt.Fprintf(&sb, " - use '%s' instead", replacedBy)
+	}
+	return sb.String()
+}
+
+func (t *Table) deprecationMessage() string {
+	return deprecationMessage("table", t.Name, t.ReplacedBy, t.RemovalVersion)
+}
+
+func (c *Column) deprecationMessage() string {
+	return deprecationMessage("column", c.Name, c.ReplacedBy, c.RemovalVersion)
+}
+
+// initialiseColumnAliases resolves the replacement column for deprecated column aliases,
+// i.e. deprecated columns which set ReplacedBy but do not define their own Hydrate or Transform
+// NOTE: this must be called before the columns are initialised
+func (t *Table) initialiseColumnAliases() {
+	for _, c := range t.Columns {
+		if !c.Deprecated || c.ReplacedBy == "" || c.Hydrate != nil || c.Transform != nil {
+			continue
+		}
+		replacement := t.getColumn(c.ReplacedBy)
+		// an invalid replacement is reported by validate
+		if replacement == nil || replacement == c {
+			continue
+		}
+		log.Printf("[TRACE] table %s: deprecated column %s is an alias of %s", t.Name, c.Name, replacement.Name)
+		c.aliasOf = replacement
+		c.Hydrate = replacement.Hydrate
+	}
+}
+
+// valueColumn returns the column whose transforms are used to populate this column
+// - for a deprecated column alias, this is the replacement column
+func (c *Column) valueColumn() *Column {
+	if c.aliasOf != nil {
+		return c.aliasOf
+	}
+	return c
+}
+
+func (t *Table) validateDeprecation() []string {
+	var validationErrors []string
+	if !t.Deprecated && (t.ReplacedBy != "" || t.RemovalVersion != "") {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' sets ReplacedBy or RemovalVersion but is not Deprecated", t.Name))
+	}
+	if t.ReplacedBy == t.Name {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' cannot be replaced by itself", t.Name))
+	}
+	return validationErrors
+}
+
+func (c *Column) validateDeprecation(table *Table) []string {
+	var validationErrors []string
+	if !c.Deprecated && (c.ReplacedBy != "" || c.RemovalVersion != "") {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' column '%s' sets ReplacedBy or RemovalVersion but is not Deprecated", table.Name, c.Name))
+	}
+	if c.ReplacedBy == "" {
+		return validationErrors
+	}
+	replacement := table.getColumn(c.ReplacedBy)
+	switch {
+	case replacement == nil:
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' column '%s' is replaced by column '%s', which does not exist", table.Name, c.Name, c.ReplacedBy))
+	case replacement == c:
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' column '%s' cannot be replaced by itself", table.Name, c.Name))
+	case c.aliasOf != nil && replacement.aliasOf != nil:
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' column '%s' is an alias of column '%s', which is itself an alias", table.Name, c.Name, c.ReplacedBy))
+	case c.aliasOf != nil && replacement.Type != c.Type:
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' column '%s' is an alias of column '%s' so must have the same type ('%s'), but is type '%s'", table.Name, c.Name, c.ReplacedBy, columnTypeToString(replacement.Type), columnTypeToString(c.Type)))
+	}
+	return validationErrors
+}
+
+// addDeprecationWarnings adds a query warning if the table, or any of the requested columns, is deprecated
+func (d *QueryData) addDeprecationWarnings() {
+	if d.queryStatus == nil || d.queryStatus.warnings == nil {
+		return
+	}
+	var messages []string
+	if d.Table.Deprecated {
+		messages = append(messages, d.Table.deprecationMessage())
+	}
+	for _, columnName := range d.QueryContext.Columns {
+		if column := d.Table.getColumn(columnName); column != nil && column.Deprecated {
+			messages = append(messages, column.deprecationMessage())
+		}
+	}
+	for _, message := range messages {
+		log.Printf("[INFO] %s (%s)", message, d.connectionCallId)
+		warning := &proto.QueryWarning{
+			Table:   d.Table.Name,
+			Message: message,
+		}
+		if d.Connection != nil {
+			warning.Connection = d.Connection.Name
+		}
+		d.queryStatus.warnings.add(warning)
+	}
+}
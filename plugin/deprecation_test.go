@@ -0,0 +1,224 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func TestDeprecationMessage(t *testing.T) {
+	tests := map[string]struct {
+		column   *Column
+		expected string
+	}{
+		"deprecated": {
+			column:   &Column{Name: "old", Deprecated: true},
+			expected: "column 'old' is deprecated",
+		},
+		"replaced": {
+			column:   &Column{Name: "old", Deprecated: true, ReplacedBy: "new"},
+			expected: "column 'old' is deprecated - use 'new' instead",
+		},
+		"replaced with removal version": {
+			column:   &Column{Name: "old", Deprecated: true, ReplacedBy: "new", RemovalVersion: "v2.0.0"},
+			expected: "column 'old' is deprecated and will be removed in v2.0.0 - use 'new' instead",
+		},
+	}
+	for name, test := range tests {
+		if message := test.column.deprecationMessage(); message != test.expected {
+			t.Errorf("test %s: expected '%s', got '%s'", name, test.expected, message)
+		}
+	}
+}
+
+type testRegionItem struct {
+	Region string
+}
+
+// newTestDeprecatedTable returns an initialised table with a deprecated column alias 'region_name' of 'region'
+func newTestDeprecatedTable(regionColumn *Column, defaultTransform *transform.ColumnTransforms) *Table {
+	table := &Table{
+		Name:             "test",
+		Plugin:           &Plugin{},
+		DefaultTransform: defaultTransform,
+		Columns: []*Column{
+			regionColumn,
+			{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region", RemovalVersion: "v2.0.0"},
+		},
+	}
+	table.initialiseColumnAliases()
+	for _, c := range table.Columns {
+		c.initialise()
+	}
+	return table
+}
+
+func TestDeprecatedColumnAlias(t *testing.T) {
+	tests := map[string]struct {
+		table *Table
+		item  any
+	}{
+		"replacement transform": {
+			table: newTestDeprecatedTable(&Column{Name: "region", Type: proto.ColumnType_STRING, Transform: transform.FromField("Region")}, nil),
+			item:  &testRegionItem{Region: "us-east-1"},
+		},
+		"default transform": {
+			table: newTestDeprecatedTable(&Column{Name: "region", Type: proto.ColumnType_STRING}, nil),
+			item:  map[string]any{"region": "us-east-1"},
+		},
+		"table default transform using the column name": {
+			table: newTestDeprecatedTable(&Column{Name: "region", Type: proto.ColumnType_STRING}, transform.FromCamel()),
+			item:  &testRegionItem{Region: "us-east-1"},
+		},
+	}
+	for name, test := range tests {
+		d := &QueryData{
+			Table:            test.table,
+			QueryContext:     &QueryContext{},
+			columns:          map[string]*QueryColumn{},
+			hydrateColumnMap: map[string][]string{"list": {"region", "region_name"}},
+		}
+		d.addColumnsForHydrate("list")
+		rd := newRowData(d, test.item)
+		rd.set("list", test.item)
+		row, err := rd.getColumnValues(context.Background())
+		if err != nil {
+			t.Errorf("test %s: getColumnValues failed: %s", name, err)
+			continue
+		}
+		region, regionName := row.Columns["region"].GetStringValue(), row.Columns["region_name"].GetStringValue()
+		if region != "us-east-1" || regionName != region {
+			t.Errorf("test %s: expected region and region_name to be 'us-east-1', got '%s' and '%s'", name, region, regionName)
+		}
+	}
+}
+
+func TestDeprecatedColumnAliasHydrate(t *testing.T) {
+	table := newTestDeprecatedTable(&Column{Name: "region", Type: proto.ColumnType_STRING, Hydrate: getRegion}, nil)
+	alias := table.getColumn("region_name")
+	if alias.aliasOf == nil || alias.namedHydrate.Name != table.getColumn("region").namedHydrate.Name {
+		t.Errorf("expected alias to use the hydrate function of the replacement column")
+	}
+}
+
+func getRegion(context.Context, *QueryData, *HydrateData) (interface{}, error) {
+	return &testRegionItem{Region: "us-east-1"}, nil
+}
+
+func TestValidateDeprecation(t *testing.T) {
+	tests := map[string]struct {
+		columns  []*Column
+		expected string
+	}{
+		"valid": {
+			columns: []*Column{
+				{Name: "region", Type: proto.ColumnType_STRING},
+				{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+			},
+		},
+		"not deprecated": {
+			columns: []*Column{
+				{Name: "region", Type: proto.ColumnType_STRING},
+				{Name: "region_name", Type: proto.ColumnType_STRING, ReplacedBy: "region"},
+			},
+			expected: "table 'test' column 'region_name' sets ReplacedBy or RemovalVersion but is not Deprecated",
+		},
+		"missing replacement": {
+			columns: []*Column{
+				{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+			},
+			expected: "table 'test' column 'region_name' is replaced by column 'region', which does not exist",
+		},
+		"alias type mismatch": {
+			columns: []*Column{
+				{Name: "region", Type: proto.ColumnType_JSON},
+				{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+			},
+			expected: "table 'test' column 'region_name' is an alias of column 'region' so must have the same type",
+		},
+		"type mismatch with own transform": {
+			columns: []*Column{
+				{Name: "region", Type: proto.ColumnType_JSON},
+				{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region", Transform: transform.FromField("RegionName")},
+			},
+		},
+		"alias of alias": {
+			columns: []*Column{
+				{Name: "region", Type: proto.ColumnType_STRING},
+				{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+				{Name: "region_id", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region_name"},
+			},
+			expected: "table 'test' column 'region_id' is an alias of column 'region_name', which is itself an alias",
+		},
+	}
+	for name, test := range tests {
+		table := &Table{Name: "test", Columns: test.columns}
+		table.initialiseColumnAliases()
+		var validationErrors []string
+		for _, c := range table.Columns {
+			validationErrors = append(validationErrors, c.validateDeprecation(table)...)
+		}
+		if test.expected == "" {
+			if len(validationErrors) > 0 {
+				t.Errorf("test %s: expected no errors, got %v", name, validationErrors)
+			}
+			continue
+		}
+		if len(validationErrors) != 1 || !strings.HasPrefix(validationErrors[0], test.expected) {
+			t.Errorf("test %s: expected error '%s', got %v", name, test.expected, validationErrors)
+		}
+	}
+}
+
+func TestDeprecationWarnings(t *testing.T) {
+	table := &Table{
+		Name:       "test",
+		Deprecated: true,
+		ReplacedBy: "test_v2",
+		Columns: []*Column{
+			{Name: "region", Type: proto.ColumnType_STRING},
+			{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+		},
+	}
+	d := &QueryData{
+		Table:        table,
+		Connection:   &Connection{Name: "c1"},
+		QueryContext: &QueryContext{Columns: []string{"region", "region_name"}},
+		queryStatus:  newQueryStatus(nil),
+	}
+	d.addDeprecationWarnings()
+
+	warnings, _ := d.queryStatus.warnings.toProto()
+	expected := []string{
+		"table 'test' is deprecated - use 'test_v2' instead",
+		"column 'region_name' is deprecated - use 'region' instead",
+	}
+	if len(warnings) != len(expected) {
+		t.Fatalf("expected %d warnings, got %v", len(expected), warnings)
+	}
+	for i, warning := range warnings {
+		if warning.Message != expected[i] || warning.Table != "test" || warning.Connection != "c1" {
+			t.Errorf("expected warning '%s', got %v", expected[i], warning)
+		}
+	}
+}
+
+func TestGetSchemaDeprecation(t *testing.T) {
+	table := newTestDeprecatedTable(&Column{Name: "region", Type: proto.ColumnType_STRING}, nil)
+	table.Deprecated = true
+	table.ReplacedBy = "test_v2"
+	schema, err := table.GetSchema()
+	if err != nil {
+		t.Fatalf("GetSchema failed: %s", err)
+	}
+	if !schema.Deprecated || schema.ReplacedBy != "test_v2" {
+		t.Errorf("expected table schema to be deprecated, got %v", schema)
+	}
+	column := schema.GetColumnMap()["region_name"]
+	if !column.Deprecated || column.ReplacedBy != "region" || column.RemovalVersion != "v2.0.0" {
+		t.Errorf("expected column definition to be deprecated, got %v", column)
+	}
+}
@@ -35,6 +35,7 @@ func getMaxConcurrentConnections() int {
 	return maxConcurrentConnections
 }
 
+// Deprecated: memory is no longer freed periodically while streaming rows - set a memory limit using GOMEMLIMIT instead
 func GetFreeMemInterval() int64 {
 	freeMemInterval := defaultFreeMemInterval
 	intervalEnv, ok := os.LookupEnv(envFreeMemInterval)
@@ -43,11 +44,18 @@ func GetFreeMemInterval() int64 {
 			freeMemInterval = parsedInterval
 		}
 	}
-	log.Printf("[INFO] Setting free memory interval to %d rows", freeMemInterval)
+	logFreeMemIntervalDeprecation()
 
 	return int64(freeMemInterval)
 }
 
+// logFreeMemIntervalDeprecation logs a warning if STEAMPIPE_FREE_MEM_INTERVAL is set, as it no longer has any effect
+func logFreeMemIntervalDeprecation() {
+	if _, ok := os.LookupEnv(envFreeMemInterval); ok {
+		log.Printf("[WARN] %s is deprecated and has no effect - memory is no longer freed periodically while streaming rows, set a memory limit using GOMEMLIMIT instead", envFreeMemInterval)
+	}
+}
+
 func loadDiagnosticsEnvVar() string {
 	// load both the legacy and current diagnostics env vars
 	diagnostics := strings.ToUpper(os.Getenv(EnvLegacyDiagnosticsLevel))
@@ -13,6 +13,9 @@ type fetchCallRateLimiters struct {
 
 	// rate limiters for the child list call - populated if this is a list call and the list has a parent hydrate
 	childListRateLimiter *rate_limiter.MultiLimiter
+
+	// rate limiters for each level of a parent list chain (the first level uses rateLimiter)
+	parentListRateLimiters []*rate_limiter.MultiLimiter
 }
 
 // if there is a fetch call rate limiter, wait for it
@@ -23,6 +26,22 @@ func (l fetchCallRateLimiters) wait(ctx context.Context) time.Duration {
 	return 0
 }
 
+// if there is a rate limiter for the given level of a parent list chain, wait for it
+func (l fetchCallRateLimiters) parentListWait(ctx context.Context, level int) time.Duration {
+	if level < len(l.parentListRateLimiters) && l.parentListRateLimiters[level] != nil {
+		return l.parentListRateLimiters[level].Wait(ctx)
+	}
+	return 0
+}
+
+// the names of the rate limiters for the given level of a parent list chain
+func (l fetchCallRateLimiters) parentListLimiterNames(level int) []string {
+	if level < len(l.parentListRateLimiters) && l.parentListRateLimiters[level] != nil {
+		return l.parentListRateLimiters[level].LimiterNames()
+	}
+	return nil
+}
+
 // if there is a 'childList' rate limiter, wait for it
 func (l fetchCallRateLimiters) childListWait(ctx context.Context) time.Duration {
 	if l.childListRateLimiter != nil {
@@ -3,6 +3,7 @@ package plugin
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/gertd/go-pluralize"
 	"github.com/turbot/go-kit/helpers"
@@ -71,7 +72,17 @@ type GetConfig struct {
 	IgnoreConfig *IgnoreConfig
 	// a function which will return whenther to retry the call if an error is returned
 	RetryConfig *RetryConfig
-	Tags        map[string]string
+	// fail fast when the get function is repeatedly failing
+	CircuitBreakerConfig *CircuitBreakerConfig
+	// the timeout for each call of the get function - defaults to the table DefaultTimeout
+	// NOTE: the timeout is enforced using the context deadline, so the function must respect context cancellation
+	Timeout time.Duration
+	// optional batch version of the get function, called when the get key column has an IN-list qual
+	// if not set, Hydrate is called for each qual value
+	BatchHydrate GetBatchHydrateFunc
+	// the maximum number of qual values passed to each BatchHydrate call - defaults to 100
+	BatchSize int
+	Tags      map[string]string
 
 	// Deprecated: use IgnoreConfig
 	ShouldIgnoreError ErrorPredicate
@@ -124,6 +135,20 @@ func (c *GetConfig) initialise(table *Table) {
 		// then default to the table default
 		c.RetryConfig.DefaultTo(table.DefaultRetryConfig)
 		c.IgnoreConfig.DefaultTo(table.DefaultIgnoreConfig)
+		// default circuit breaker config to plugin default
+		if c.CircuitBreakerConfig == nil {
+			c.CircuitBreakerConfig = table.Plugin.DefaultCircuitBreakerConfig
+		}
+		// default timeout to table default
+		if c.Timeout == 0 {
+			c.Timeout = table.DefaultTimeout
+		}
+	}
+	if c.CircuitBreakerConfig != nil {
+		c.CircuitBreakerConfig.initialise()
+	}
+	if c.BatchHydrate != nil && c.BatchSize == 0 {
+		c.BatchSize = defaultGetBatchSize
 	}
 	log.Printf("[TRACE] GetConfig.initialise complete: RetryConfig: %s, IgnoreConfig: %s", c.RetryConfig.String(), c.IgnoreConfig.String())
 
@@ -146,6 +171,13 @@ func (c *GetConfig) Validate(table *Table) []string {
 	if c.IgnoreConfig != nil {
 		validationErrors = append(validationErrors, c.IgnoreConfig.validate(table)...)
 	}
+	if c.CircuitBreakerConfig != nil {
+		validationErrors = append(validationErrors, c.CircuitBreakerConfig.validate(table)...)
+	}
+	validationErrors = append(validationErrors, validateTimeout(c.Timeout, "GetConfig Timeout", table)...)
+	if c.BatchSize < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' GetConfig BatchSize cannot be negative", table.Name))
+	}
 	// ensure that if there is an explicit hydrate config for the get hydrate, it does not declare dependencies
 	getHydrateName := helpers.GetFunctionName(table.Get.Hydrate)
 	for _, h := range table.HydrateConfig {
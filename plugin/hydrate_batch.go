@@ -0,0 +1,240 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHydrateBatchSize    = 100
+	defaultHydrateBatchLatency = 50 * time.Millisecond
+)
+
+/*
+BatchHydrateFunc is a batch version of a [HydrateFunc], which hydrates multiple rows in a single call.
+
+It is passed the [HydrateData] for each row in the batch, and must return a slice of results
+with one entry per row, in the same order. A nil entry indicates there is no data for that row.
+
+To use a batch hydrate function, set [plugin.HydrateConfig.BatchFunc]:
+
+	HydrateConfig: []plugin.HydrateConfig{
+		{
+			Func:      getInstanceTags,
+			BatchFunc: getInstanceTagsBatch,
+			BatchSize: 100,
+		},
+	},
+
+	func getInstanceTagsBatch(ctx context.Context, d *plugin.QueryData, h []*plugin.HydrateData) ([]interface{}, error) {
+		ids := make([]string, len(h))
+		for i, hydrateData := range h {
+			ids[i] = hydrateData.Item.(*Instance).Id
+		}
+		tags, err := client.DescribeTags(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		res := make([]interface{}, len(h))
+		for i, id := range ids {
+			res[i] = tags[id]
+		}
+		return res, nil
+	}
+
+NOTE: Func must still be set - columns continue to refer to Func as their Hydrate function.
+
+NOTE: a batch may contain rows from different copies of the [QueryData] for the query, for example rows for different
+parent items, or for different values of an IN list key qual. The QueryData and context passed to the batch function
+are those of the first row in the batch, so the batch function must not depend on row specific QueryData properties
+(such as EqualsQuals) - row specific data must be read from the HydrateData of each row.
+*/
+type BatchHydrateFunc func(context.Context, *QueryData, []*HydrateData) ([]interface{}, error)
+
+// hydrateBatcher collects the rows for a batched hydrate call and executes the batch hydrate function
+// when the batch is full, or when the batch latency has elapsed since the first row was added
+type hydrateBatcher struct {
+	call    *hydrateCall
+	pending []*hydrateBatchItem
+	timer   *time.Timer
+	mut     sync.Mutex
+}
+
+type hydrateBatchItem struct {
+	ctx context.Context
+	row *rowData
+}
+
+func newHydrateBatcher(call *hydrateCall) *hydrateBatcher {
+	return &hydrateBatcher{call: call}
+}
+
+// hydrateBatchers is the set of batchers for a batched hydrate call, keyed by the resolved rate limiter scope values
+//
+// the hydrate call is copied for each matrix item and parent item, and the copies share the batchers,
+// so that rows from different copies are batched together
+// - copies whose rate limiter scope values differ (e.g. different matrix items) use different batchers
+type hydrateBatchers struct {
+	batchers map[string]*hydrateBatcher
+	mut      sync.Mutex
+}
+
+func newHydrateBatchers() *hydrateBatchers {
+	return &hydrateBatchers{batchers: make(map[string]*hydrateBatcher)}
+}
+
+// get returns the batcher for the given call, creating it if needed
+func (b *hydrateBatchers) get(call *hydrateCall) *hydrateBatcher {
+	var key string
+	if call.rateLimiter != nil {
+		key = rate_limiter.FormatStringMap(call.rateLimiter.ScopeValues)
+	}
+
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	batcher, ok := b.batchers[key]
+	if !ok {
+		batcher = newHydrateBatcher(call)
+		b.batchers[key] = batcher
+	}
+	return batcher
+}
+
+// add a row to the batch
+// NOTE: the caller must have incremented the row wait group - this is decremented when the batch completes
+func (b *hydrateBatcher) add(ctx context.Context, r *rowData) {
+	config := b.call.Config
+
+	b.mut.Lock()
+	b.pending = append(b.pending, &hydrateBatchItem{ctx: ctx, row: r})
+	if len(b.pending) >= config.BatchSize {
+		batch := b.take()
+		b.mut.Unlock()
+		go b.execute(batch)
+		return
+	}
+	// if this is the first item in the batch, start the latency timer
+	if len(b.pending) == 1 {
+		b.timer = time.AfterFunc(config.BatchLatency, b.flush)
+	}
+	b.mut.Unlock()
+}
+
+// flush executes any pending rows
+func (b *hydrateBatcher) flush() {
+	b.mut.Lock()
+	batch := b.take()
+	b.mut.Unlock()
+
+	if len(batch) > 0 {
+		b.execute(batch)
+	}
+}
+
+// take removes and returns the pending rows
+// NOTE: the lock must be held by the caller
+func (b *hydrateBatcher) take() []*hydrateBatchItem {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+// execute the batch hydrate function and set the result (or error) on each row
+func (b *hydrateBatcher) execute(batch []*hydrateBatchItem) {
+	h := b.call
+	// the rows in a batch may come from different copies of the query data (e.g. for different parent items)
+	// - use the query data and context of the first row
+	d := batch[0].row.queryData
+	ctx := batch[0].ctx
+
+	var results []interface{}
+	var err error
+	defer func() {
+		if p := recover(); p != nil {
+			log.Printf("[WARN] batch hydrate call %s recover: %v", h.Name, p)
+			err = status.Error(codes.Internal, fmt.Sprintf("batch hydrate call %s failed with panic %v", h.Name, p))
+		}
+		b.setResults(batch, results, err)
+	}()
+
+	log.Printf("[TRACE] executing batch hydrate call %s for %d rows (%s)", h.Name, len(batch), d.connectionCallId)
+
+	// apply rate limiting and concurrency limits to the batch
+	if err = h.acquireBatchSemaphore(ctx); err != nil {
+		return
+	}
+	defer h.onFinished()
+	rateLimitDelay := h.rateLimit(ctx, d)
+	d.getHydrateStats(h.Name).onRateLimit(rateLimitDelay, h.rateLimiter.LimiterNames())
+
+	// update the hydrate count
+	atomic.AddInt64(&d.queryStatus.hydrateCalls, 1)
+
+	hydrateData := make([]*HydrateData, len(batch))
+	for i, item := range batch {
+		hydrateData[i] = item.row.hydrateData()
+	}
+
+	// wrap the batch func as a hydrate func, so the batch is called with the same
+	// timeout, circuit breaker, ignore and retry behaviour as a non-batched call
+	batchHydrate := namedHydrateFunc{
+		Name: h.Name,
+		Func: func(ctx context.Context, d *QueryData, _ *HydrateData) (interface{}, error) {
+			return h.Config.BatchFunc(ctx, d, hydrateData)
+		},
+	}
+	batchHydrate = h.circuitBreaker.wrap(withTimeout(batchHydrate, h.Config.Timeout), h.Config.IgnoreConfig)
+
+	var item interface{}
+	item, err = newRowData(d, nil).callHydrateWithRetries(ctx, d, batchHydrate, h.Config.IgnoreConfig, h.Config.RetryConfig)
+	if err != nil || helpers.IsNil(item) {
+		// if the error was ignored, the item will be nil - the result for every row is nil
+		return
+	}
+	results = item.([]interface{})
+	if len(results) != len(batch) {
+		err = fmt.Errorf("batch hydrate call %s returned %d results for %d rows", h.Name, len(results), len(batch))
+	}
+}
+
+func (b *hydrateBatcher) setResults(batch []*hydrateBatchItem, results []interface{}, err error) {
+	name := b.call.Name
+	for i, item := range batch {
+		r := item.row
+		if err != nil {
+			log.Printf("[ERROR] batch hydrate call %s finished with error: %v\n", name, err)
+			r.setError(name, err)
+			r.errorChan <- err
+		} else {
+			var result interface{}
+			if results != nil {
+				result = results[i]
+			}
+			// set the hydrate data, even if it is nil
+			r.set(name, result)
+		}
+		r.wg.Done()
+	}
+}
+
+// acquireBatchSemaphore waits until the concurrency limits allow the batch to execute
+func (h *hydrateCall) acquireBatchSemaphore(ctx context.Context) error {
+	if h.rateLimiter == nil {
+		return nil
+	}
+	return h.rateLimiter.AcquireSemaphore(ctx)
+}
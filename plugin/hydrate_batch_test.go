@@ -0,0 +1,125 @@
+package plugin
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+)
+
+func newTestBatchHydrateCall(batchFunc BatchHydrateFunc, batchSize int) *hydrateCall {
+	d := &QueryData{
+		Table:       &Table{Name: "test", Plugin: &Plugin{Name: "test"}},
+		Connection:  &Connection{Name: "c1"},
+		queryStatus: newQueryStatus(nil),
+	}
+	config := &HydrateConfig{
+		Func:         hydrate1,
+		BatchFunc:    batchFunc,
+		BatchSize:    batchSize,
+		BatchLatency: 20 * time.Millisecond,
+		RetryConfig:  &RetryConfig{},
+		IgnoreConfig: &IgnoreConfig{},
+	}
+	config.namedHydrate = newNamedHydrateFunc(config.Func)
+	h, _ := newHydrateCall(config, d)
+	h.rateLimiter = rate_limiter.EmptyMultiLimiter()
+	return h
+}
+
+func TestHydrateBatcher(t *testing.T) {
+	var batchSizes []int
+	var mut sync.Mutex
+	h := newTestBatchHydrateCall(func(_ context.Context, _ *QueryData, hydrateData []*HydrateData) ([]interface{}, error) {
+		mut.Lock()
+		batchSizes = append(batchSizes, len(hydrateData))
+		mut.Unlock()
+		res := make([]interface{}, len(hydrateData))
+		for i, hd := range hydrateData {
+			res[i] = hd.Item.(int) * 10
+		}
+		return res, nil
+	}, 3)
+
+	// add 4 rows - the first 3 execute as a full batch, the last when the latency elapses
+	rows := make([]*rowData, 4)
+	for i := range rows {
+		rows[i] = newRowData(h.queryData, i)
+		rows[i].wg.Add(1)
+		h.batchers.get(h).add(context.Background(), rows[i])
+	}
+	for i, r := range rows {
+		r.wg.Wait()
+		if r.hydrateResults[h.Name] != i*10 {
+			t.Errorf("row %d: expected result %d, got %v", i, i*10, r.hydrateResults[h.Name])
+		}
+	}
+	if len(batchSizes) != 2 || batchSizes[0]+batchSizes[1] != 4 {
+		t.Errorf("expected 2 batches of 4 rows, got %v", batchSizes)
+	}
+	if calls := h.queryData.queryStatus.hydrateCalls; calls != 2 {
+		t.Errorf("expected 2 hydrate calls, got %d", calls)
+	}
+}
+
+func TestHydrateBatcherResultMismatch(t *testing.T) {
+	h := newTestBatchHydrateCall(func(context.Context, *QueryData, []*HydrateData) ([]interface{}, error) {
+		return []interface{}{"only one"}, nil
+	}, 2)
+
+	rows := []*rowData{newRowData(h.queryData, 1), newRowData(h.queryData, 2)}
+	for _, r := range rows {
+		r.wg.Add(1)
+		h.batchers.get(h).add(context.Background(), r)
+	}
+	for i, r := range rows {
+		r.wg.Wait()
+		if r.hydrateErrors[h.Name] == nil {
+			t.Errorf("row %d: expected an error", i)
+		}
+	}
+}
+
+func TestHydrateBatchersShared(t *testing.T) {
+	h := newTestBatchHydrateCall(func(context.Context, *QueryData, []*HydrateData) ([]interface{}, error) {
+		return nil, nil
+	}, 2)
+
+	// copies of the call (e.g. for each parent item) share a batcher
+	copied := h.shallowCopy()
+	if h.batchers.get(h) != copied.batchers.get(copied) {
+		t.Error("expected copies of the hydrate call to share a batcher")
+	}
+
+	// copies with different rate limiter scope values (e.g. a different matrix item) do not
+	copied.rateLimiter = rate_limiter.NewMultiLimiter(nil, map[string]string{"region": "us-east-1"})
+	if h.batchers.get(h) == copied.batchers.get(copied) {
+		t.Error("expected copies with different scope values to use different batchers")
+	}
+}
+
+func TestHydrateBatcherQueryData(t *testing.T) {
+	var batchQueryData *QueryData
+	h := newTestBatchHydrateCall(func(_ context.Context, d *QueryData, hydrateData []*HydrateData) ([]interface{}, error) {
+		batchQueryData = d
+		return make([]interface{}, len(hydrateData)), nil
+	}, 2)
+
+	// rows from different copies of the query data are batched together
+	// - the batch func is passed the query data of the first row
+	copied := h.shallowCopy()
+	copied.queryData = h.queryData.shallowCopy()
+	rows := []*rowData{newRowData(copied.queryData, 1), newRowData(h.queryData, 2)}
+	rows[0].wg.Add(1)
+	copied.batchers.get(copied).add(context.Background(), rows[0])
+	rows[1].wg.Add(1)
+	h.batchers.get(h).add(context.Background(), rows[1])
+	for _, r := range rows {
+		r.wg.Wait()
+	}
+	if batchQueryData != copied.queryData {
+		t.Error("expected the batch func to be passed the query data of the first row")
+	}
+}
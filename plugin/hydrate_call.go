@@ -16,8 +16,12 @@ type hydrateCall struct {
 	Depends []namedHydrateFunc
 	Config  *HydrateConfig
 
-	queryData   *QueryData
-	rateLimiter *rate_limiter.MultiLimiter
+	queryData      *QueryData
+	rateLimiter    *rate_limiter.MultiLimiter
+	circuitBreaker *circuitBreaker
+	// if the hydrate config defines a BatchFunc, the batchers collect rows into batches
+	// (these are shared by all copies of the call)
+	batchers *hydrateBatchers
 }
 
 func newHydrateCall(config *HydrateConfig, d *QueryData) (*hydrateCall, error) {
@@ -32,21 +36,27 @@ func newHydrateCall(config *HydrateConfig, d *QueryData) (*hydrateCall, error) {
 	for _, f := range config.Depends {
 		res.Depends = append(res.Depends, newNamedHydrateFunc(f))
 	}
+	if config.BatchFunc != nil {
+		res.batchers = newHydrateBatchers()
+	}
 
 	return res, nil
 }
 
 func (h *hydrateCall) shallowCopy() *hydrateCall {
-	return &hydrateCall{
+	res := &hydrateCall{
 		namedHydrateFunc: namedHydrateFunc{
 			Func: h.Func,
 			Name: h.Name,
 		},
-		Depends:     h.Depends,
-		Config:      h.Config,
-		queryData:   h.queryData,
-		rateLimiter: h.rateLimiter,
+		Depends:        h.Depends,
+		Config:         h.Config,
+		queryData:      h.queryData,
+		rateLimiter:    h.rateLimiter,
+		circuitBreaker: h.circuitBreaker,
+		batchers:       h.batchers,
 	}
+	return res
 }
 
 // identify any rate limiters which apply to this hydrate call
@@ -68,6 +78,12 @@ func (h *hydrateCall) initialiseRateLimiter() error {
 	return nil
 }
 
+// identify the circuit breaker (if any) which applies to this hydrate call
+// NOTE: this must be called after initialiseRateLimiter, as it uses the rate limiter scope values
+func (h *hydrateCall) initialiseCircuitBreaker() {
+	h.circuitBreaker = h.queryData.plugin.circuitBreakers.getOrCreate(h.Config.CircuitBreakerConfig, h.rateLimiter.ScopeValues)
+}
+
 // CanStart returns whether this hydrate call can execute
 // - check whether all dependency hydrate functions have been completed
 // - check whether the concurrency limits would be exceeded
@@ -82,6 +98,10 @@ func (h *hydrateCall) canStart(rowData *rowData) bool {
 	if h.rateLimiter == nil {
 		return true
 	}
+	// for batched calls, the concurrency limits are applied when the batch executes
+	if h.batchers != nil {
+		return true
+	}
 	// so a rate limiting config is defined - check whether we satisfy the concurrency limits
 	canStart := h.rateLimiter.TryToAcquireSemaphore()
 
@@ -97,12 +117,20 @@ func (h *hydrateCall) start(ctx context.Context, r *rowData, d *QueryData) time.
 
 	// tell the rowData to wait for this call to complete
 	r.wg.Add(1)
+
+	// if this is a batched call, add the row to the batch
+	// (the row will be hydrated when the batch executes)
+	if h.batchers != nil {
+		h.batchers.get(h).add(ctx, r)
+		return rateLimitDelay
+	}
+
 	// update the hydrate count
 	atomic.AddInt64(&d.queryStatus.hydrateCalls, 1)
 
 	// call callHydrate async, ignoring return values
 	go func() {
-		r.callHydrate(ctx, d, h.namedHydrateFunc, h.Config)
+		r.callHydrate(ctx, d, h.circuitBreaker.wrap(withTimeout(h.namedHydrateFunc, h.Config.Timeout), h.Config.IgnoreConfig), h.Config)
 		h.onFinished()
 	}()
 	// retrieve the concurrencyDelay for the call
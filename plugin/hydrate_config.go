@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
 )
@@ -15,6 +16,8 @@ HydrateConfig defines how to run a [HydrateFunc]:
 
   - Which errors to retry: [plugin.HydrateConfig.RetryConfig].
 
+  - When to stop calling a failing API: [plugin.HydrateConfig.CircuitBreakerConfig].
+
   - How many concurrent calls to allow: [plugin.HydrateConfig.MaxConcurrency].
 
   - Which hydrate calls must complete before this HydrateFunc can start: [plugin.HydrateConfig.Depends].
@@ -83,6 +86,38 @@ Note that:
   - Circular dependencies will be detected and cause a validation failure.
   - The Get and List hydrate functions ***CANNOT*** have dependencies.
 
+A hydrate function may return a collection of child items, each of which should become a separate row
+(for example, a row per rule of a security group). This is declared by setting [plugin.HydrateConfig.Explode]:
+
+	return &plugin.Table{
+			Name: "aws_vpc_security_group_rule",
+			List: &plugin.ListConfig{
+				Hydrate: listSecurityGroups,
+			},
+			HydrateConfig: []plugin.HydrateConfig{
+				{
+					Func:    getSecurityGroupRules,
+					Explode: true,
+				},
+			},
+			Columns: []*plugin.Column{
+				{Name: "group_id", Type: proto.ColumnType_STRING},
+				{Name: "rule_id", Type: proto.ColumnType_STRING, Hydrate: getSecurityGroupRules, Transform: transform.FromField("RuleId")},
+			},
+		}
+
+The explode hydrate function must return a slice. Each element becomes a row, which inherits the parent item
+and the results of all other hydrate functions called for the parent row. If the explode hydrate returns no items
+(or an ignored error), the parent produces no rows.
+
+The explode hydrate is subject to column pruning - if no column populated by the explode hydrate is requested,
+it is not called and a single row is returned for each parent item.
+
+Note that:
+  - A table may have at most one explode hydrate.
+  - Other hydrate functions cannot depend on the explode hydrate.
+  - The Get and List hydrate functions cannot be explode hydrates.
+
 Examples:
   - [aws]
   - [oci]
@@ -96,7 +131,23 @@ type HydrateConfig struct {
 	IgnoreConfig *IgnoreConfig
 	// a function which will return whenther to retry the call if an error is returned
 	RetryConfig *RetryConfig
-	Depends     []HydrateFunc
+	// fail fast when the hydrate function is repeatedly failing
+	CircuitBreakerConfig *CircuitBreakerConfig
+	// the timeout for each call of the hydrate function - defaults to the table DefaultTimeout
+	// if a call times out, it fails with a HydrateTimeoutError (which may be retried or ignored)
+	// NOTE: the timeout is enforced using the context deadline, so the function must respect context cancellation
+	Timeout time.Duration
+	Depends []HydrateFunc
+
+	// optional batch version of Func - if set, rows are collected into batches and hydrated by a single call
+	BatchFunc BatchHydrateFunc
+	// the maximum number of rows in a batch. Default set to 100.
+	BatchSize int
+	// the maximum time to wait for a batch to fill before it is executed. Default set to 50ms.
+	BatchLatency time.Duration
+
+	// if set, Func returns a slice of child items, each of which becomes a separate row
+	Explode bool
 
 	// tags - used to resolve the rate limiter for this hydrate call
 	// for example:
@@ -168,6 +219,27 @@ func (c *HydrateConfig) initialise(table *Table) {
 	if table != nil {
 		c.RetryConfig.DefaultTo(table.DefaultRetryConfig)
 		c.IgnoreConfig.DefaultTo(table.DefaultIgnoreConfig)
+		// default circuit breaker config to plugin default
+		if c.CircuitBreakerConfig == nil {
+			c.CircuitBreakerConfig = table.Plugin.DefaultCircuitBreakerConfig
+		}
+		// default timeout to table default
+		if c.Timeout == 0 {
+			c.Timeout = table.DefaultTimeout
+		}
+	}
+	if c.CircuitBreakerConfig != nil {
+		c.CircuitBreakerConfig.initialise()
+	}
+
+	// set batch defaults
+	if c.BatchFunc != nil {
+		if c.BatchSize == 0 {
+			c.BatchSize = defaultHydrateBatchSize
+		}
+		if c.BatchLatency == 0 {
+			c.BatchLatency = defaultHydrateBatchLatency
+		}
 	}
 
 	log.Printf("[TRACE] HydrateConfig.initialise complete: RetryConfig: %s, IgnoreConfig: %s", c.RetryConfig.String(), c.IgnoreConfig.String())
@@ -185,6 +257,14 @@ func (c *HydrateConfig) validate(table *Table) []string {
 	if c.IgnoreConfig != nil {
 		validationErrors = append(validationErrors, c.IgnoreConfig.validate(table)...)
 	}
+	if c.CircuitBreakerConfig != nil {
+		validationErrors = append(validationErrors, c.CircuitBreakerConfig.validate(table)...)
+	}
+	validationErrors = append(validationErrors, validateTimeout(c.Timeout, "HydrateConfig Timeout", table)...)
+	if c.BatchSize < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' HydrateConfig BatchSize cannot be negative", table.Name))
+	}
+	validationErrors = append(validationErrors, validateTimeout(c.BatchLatency, "HydrateConfig BatchLatency", table)...)
 
 	return validationErrors
 }
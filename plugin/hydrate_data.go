@@ -3,7 +3,10 @@ package plugin
 // HydrateData contains the input data passed to every hydrate function
 type HydrateData struct {
 	// if there was a parent-child list call, store the parent list item
-	ParentItem     interface{}
+	ParentItem interface{}
+	// if there was a chain of parent list calls, store the item for each level, starting with the outermost
+	// (the last item is the ParentItem)
+	ParentItems    []interface{}
 	Item           interface{}
 	HydrateResults map[string]interface{}
 }
@@ -2,8 +2,11 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/sethvargo/go-retry"
@@ -38,17 +41,23 @@ func retryNamedHydrate(ctx context.Context, d *QueryData, hydrateData *HydrateDa
 		maxAttempts = uint64(retryConfig.MaxAttempts)
 	}
 
+	// any server-provided retry delay returned by the hydrate function overrides the backoff
+	retryAfter := &retryAfterDelay{}
+
 	// Create the backoff based on the given mode
-	backoff, err := getBackoff(retryConfig)
+	backoff, err := getBackoff(retryConfig, retryAfter)
 	if err != nil {
 		return nil, err
 	}
 
+	hydrateStats := d.getHydrateStats(hydrate.Name)
 	err = retry.Do(ctx, retry.WithMaxRetries(maxAttempts, backoff), func(ctx context.Context) error {
+		hydrateStats.onRetry()
 		hydrateResult, err = hydrate.Func(ctx, d, hydrateData)
 		if err != nil {
 			log.Printf("[TRACE] >>> error %s", err.Error())
-			if shouldRetryError(ctx, d, hydrateData, err, retryConfig) {
+			if shouldRetryError(ctx, d, hydrateData, hydrate.Name, err, retryConfig) {
+				retryAfter.set(err)
 				err = retry.RetryableError(err)
 			}
 		}
@@ -58,9 +67,10 @@ func retryNamedHydrate(ctx context.Context, d *QueryData, hydrateData *HydrateDa
 	return hydrateResult, err
 }
 
-func getBackoff(retryConfig *RetryConfig) (retry.Backoff, error) {
+func getBackoff(retryConfig *RetryConfig, retryAfter *retryAfterDelay) (retry.Backoff, error) {
 	// Default set to Fibonacci
 	backoffAlgorithm := "Fibonacci"
+	jitter := "None"
 	var retryIntervalMs int64 = 100
 	var cappedDurationMs, maxDurationMs int64
 
@@ -69,6 +79,9 @@ func getBackoff(retryConfig *RetryConfig) (retry.Backoff, error) {
 		if retryConfig.BackoffAlgorithm != "" {
 			backoffAlgorithm = retryConfig.BackoffAlgorithm
 		}
+		if retryConfig.Jitter != "" {
+			jitter = retryConfig.Jitter
+		}
 		if retryConfig.RetryInterval != 0 {
 			retryIntervalMs = retryConfig.RetryInterval
 		}
@@ -84,6 +97,7 @@ func getBackoff(retryConfig *RetryConfig) (retry.Backoff, error) {
 	var err error
 	// convert retryIntervalMs into a duration
 	retryInterval := time.Duration(retryIntervalMs) * time.Millisecond
+	cappedDuration := time.Duration(cappedDurationMs) * time.Millisecond
 	switch backoffAlgorithm {
 	case "Fibonacci":
 		backoff = retry.NewFibonacci(retryInterval)
@@ -96,9 +110,22 @@ func getBackoff(retryConfig *RetryConfig) (retry.Backoff, error) {
 		return nil, err
 	}
 
+	// apply jitter
+	switch jitter {
+	case "Full":
+		backoff = withFullJitter(backoff)
+	case "Decorrelated":
+		// decorrelated jitter calculates each delay from the previous one, so replaces the backoff algorithm
+		backoff = newDecorrelatedJitterBackoff(retryInterval, cappedDuration)
+	}
+
 	// Apply additional caps or limit
 	if cappedDurationMs != 0 {
-		backoff = retry.WithCappedDuration(time.Duration(cappedDurationMs)*time.Millisecond, backoff)
+		backoff = retry.WithCappedDuration(cappedDuration, backoff)
+	}
+	// a server-provided retry delay is not subject to CappedDuration, but is still limited by MaxDuration
+	if retryAfter != nil {
+		backoff = withRetryAfter(retryAfter, backoff)
 	}
 	if maxDurationMs != 0 {
 		backoff = retry.WithMaxDuration(time.Duration(maxDurationMs)*time.Second, backoff)
@@ -107,6 +134,99 @@ func getBackoff(retryConfig *RetryConfig) (retry.Backoff, error) {
 	return backoff, nil
 }
 
+// withFullJitter wraps a backoff, returning a random delay between zero and the delay returned by the backoff
+// (see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/)
+func withFullJitter(next retry.Backoff) retry.Backoff {
+	return retry.BackoffFunc(func() (time.Duration, bool) {
+		val, stop := next.Next()
+		if stop {
+			return 0, true
+		}
+		if val <= 0 {
+			return 0, false
+		}
+		return time.Duration(rand.Int63n(int64(val) + 1)), false
+	})
+}
+
+// newDecorrelatedJitterBackoff returns a backoff where each delay is a random value between the base interval
+// and 3 times the previous delay, limited to cappedDuration (if set)
+// (see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/)
+func newDecorrelatedJitterBackoff(base, cappedDuration time.Duration) retry.Backoff {
+	var mut sync.Mutex
+	prev := base
+	return retry.BackoffFunc(func() (time.Duration, bool) {
+		mut.Lock()
+		defer mut.Unlock()
+
+		upper := prev * 3
+		if cappedDuration > 0 && upper > cappedDuration {
+			upper = cappedDuration
+		}
+		next := base
+		if upper > base {
+			next += time.Duration(rand.Int63n(int64(upper-base) + 1))
+		}
+		prev = next
+		return next, false
+	})
+}
+
+// retryAfterDelay stores the server-provided delay carried by the last retryable error (if any)
+type retryAfterDelay struct {
+	delay time.Duration
+	mut   sync.Mutex
+}
+
+func (r *retryAfterDelay) set(err error) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	r.delay, _ = getRetryAfter(err)
+}
+
+// take returns the stored delay (if any) and clears it
+func (r *retryAfterDelay) take() (time.Duration, bool) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	delay := r.delay
+	r.delay = 0
+	return delay, delay > 0
+}
+
+// withRetryAfter wraps a backoff, replacing the calculated delay with the server-provided retry delay (if any)
+func withRetryAfter(retryAfter *retryAfterDelay, next retry.Backoff) retry.Backoff {
+	return retry.BackoffFunc(func() (time.Duration, bool) {
+		// always call next, so the backoff progresses
+		val, stop := next.Next()
+		if stop {
+			return 0, true
+		}
+		if delay, ok := retryAfter.take(); ok {
+			log.Printf("[TRACE] using server-provided retry delay %dms in place of backoff delay %dms", delay.Milliseconds(), val.Milliseconds())
+			return delay, false
+		}
+		return val, false
+	})
+}
+
+// waitForRetryAfter waits for the server-provided retry delay carried by err (if any)
+// this is used before the first retry, as retry.Do makes its first attempt immediately
+func waitForRetryAfter(ctx context.Context, err error) error {
+	delay, ok := getRetryAfter(err)
+	if !ok {
+		return nil
+	}
+	log.Printf("[TRACE] waiting %dms for server-provided retry delay", delay.Milliseconds())
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // WrapHydrate is a higher order function which returns a [HydrateFunc] that handles Ignorable errors.
 func WrapHydrate(hydrate namedHydrateFunc, ignoreConfig *IgnoreConfig) namedHydrateFunc {
 	res := hydrate.clone()
@@ -136,12 +256,15 @@ func WrapHydrate(hydrate namedHydrateFunc, ignoreConfig *IgnoreConfig) namedHydr
 		if err != nil {
 			log.Printf("[TRACE] wrapped hydrate call %s returned error %v, ignore config %s\n", hydrate.Name, err, ignoreConfig.String())
 			// see if the ignoreConfig defines a should ignore function
-			if ignoreConfig.ShouldIgnoreError != nil && ignoreConfig.ShouldIgnoreError(err) {
+			if ignoreConfig.shouldIgnoreError(ctx, d, h, err) {
 				log.Printf("[TRACE] wrapped hydrate call %s returned error but we are ignoring it: %v", hydrate.Name, err)
+				d.onHydrateErrorIgnored(ctx, hydrate.Name, err)
 				return nil, nil
 			}
-			if ignoreConfig.ShouldIgnoreErrorFunc != nil && ignoreConfig.ShouldIgnoreErrorFunc(ctx, d, h, err) {
-				log.Printf("[TRACE] wrapped hydrate call %s returned error but we are ignoring it: %v", hydrate.Name, err)
+			// now check the user defined connection config rules
+			if getConnectionErrorRules(d).shouldIgnoreError(ctx, d, hydrate.Name, err) {
+				log.Printf("[TRACE] wrapped hydrate call %s returned error but connection config rules ignore it: %v", hydrate.Name, err)
+				d.onHydrateErrorIgnored(ctx, hydrate.Name, err)
 				return nil, nil
 			}
 			// pass any other error on
@@ -153,22 +276,50 @@ func WrapHydrate(hydrate namedHydrateFunc, ignoreConfig *IgnoreConfig) namedHydr
 	return res
 }
 
-func shouldRetryError(ctx context.Context, d *QueryData, h *HydrateData, err error, retryConfig *RetryConfig) bool {
+func shouldRetryError(ctx context.Context, d *QueryData, h *HydrateData, hydrateName string, err error, retryConfig *RetryConfig) bool {
 	if retryConfig == nil {
 		log.Printf("[WARN] nil retry config passed to shouldRetryError this is unexpected - returning false")
 		return false
 	}
 	log.Printf("[TRACE] shouldRetryError err: %v, retryConfig: %s", err, retryConfig.String())
 
+	// never retry if the circuit breaker is open
+	var circuitBreakerOpenError *CircuitBreakerOpenError
+	if errors.As(err, &circuitBreakerOpenError) {
+		log.Printf("[TRACE] shouldRetryError - circuit breaker is open - returning false")
+		return false
+	}
+
 	if retryConfig.ShouldRetryError != nil {
 		log.Printf("[TRACE] shouldRetryError - calling legacy ShouldRetryError")
-		return retryConfig.ShouldRetryError(err)
+		if retryConfig.ShouldRetryError(err) {
+			return true
+		}
+	} else if retryConfig.ShouldRetryErrorFunc != nil {
+		log.Printf("[TRACE] shouldRetryError - calling ShouldRetryFunc")
+		if retryConfig.ShouldRetryErrorFunc(ctx, d, h, err) {
+			return true
+		}
 	}
 
-	if retryConfig.ShouldRetryErrorFunc != nil {
-		log.Printf("[TRACE] shouldRetryError - calling ShouldRetryFunc")
-		return retryConfig.ShouldRetryErrorFunc(ctx, d, h, err)
+	// the plugin does not retry this error - check the user defined connection config rules
+	if getConnectionErrorRules(d).shouldRetryError(ctx, d, hydrateName, err) {
+		// we cannot retry errors in the list hydrate function after streaming has started
+		if retryConfig.isListRetryConfig && d.queryStatus.rowsStreamed.Load() != 0 {
+			log.Printf("[TRACE] shouldRetryError we have started streaming rows (%d) - return false", d.queryStatus.rowsStreamed.Load())
+			return false
+		}
+		log.Printf("[TRACE] shouldRetryError - connection config rules retry error")
+		return true
 	}
 
 	return false
 }
+
+// return the user defined error rules for the query connection (if any)
+func getConnectionErrorRules(d *QueryData) *connectionErrorRules {
+	if d == nil || d.Connection == nil {
+		return nil
+	}
+	return d.Connection.errorRules
+}
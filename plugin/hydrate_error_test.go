@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type backoffTest struct {
+	retryConfig *RetryConfig
+	// the expected range of each delay
+	min []time.Duration
+	max []time.Duration
+}
+
+var testCasesGetBackoff = map[string]backoffTest{
+	"constant": {
+		retryConfig: &RetryConfig{BackoffAlgorithm: "Constant", RetryInterval: 100},
+		min:         []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+		max:         []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+	},
+	"exponential full jitter": {
+		retryConfig: &RetryConfig{BackoffAlgorithm: "Exponential", Jitter: "Full", RetryInterval: 100},
+		min:         []time.Duration{0, 0, 0},
+		max:         []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond},
+	},
+	"decorrelated jitter capped": {
+		retryConfig: &RetryConfig{Jitter: "Decorrelated", RetryInterval: 100, CappedDuration: 500},
+		min:         []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+		max:         []time.Duration{300 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond},
+	},
+}
+
+func TestGetBackoff(t *testing.T) {
+	for name, test := range testCasesGetBackoff {
+		// jitter is random - repeat to exercise the range
+		for i := 0; i < 50; i++ {
+			backoff, err := getBackoff(test.retryConfig, nil)
+			if err != nil {
+				t.Fatalf("test %s: getBackoff failed: %s", name, err)
+			}
+			for j := range test.min {
+				delay, stop := backoff.Next()
+				if stop {
+					t.Fatalf("test %s: backoff stopped unexpectedly", name)
+				}
+				if delay < test.min[j] || delay > test.max[j] {
+					t.Errorf("test %s: delay %d is %s, expected between %s and %s", name, j, delay, test.min[j], test.max[j])
+				}
+			}
+		}
+	}
+}
+
+func TestGetBackoffRetryAfter(t *testing.T) {
+	retryAfter := &retryAfterDelay{}
+	backoff, err := getBackoff(&RetryConfig{BackoffAlgorithm: "Constant", RetryInterval: 100, CappedDuration: 200}, retryAfter)
+	if err != nil {
+		t.Fatalf("getBackoff failed: %s", err)
+	}
+
+	// a wrapped RetryAfterError overrides the next delay only (and is not subject to CappedDuration)
+	retryAfter.set(fmt.Errorf("throttled: %w", NewRetryAfterError(errors.New("429"), 3*time.Second)))
+	if delay, _ := backoff.Next(); delay != 3*time.Second {
+		t.Errorf("expected server-provided delay of 3s, got %s", delay)
+	}
+	if delay, _ := backoff.Next(); delay != 100*time.Millisecond {
+		t.Errorf("expected backoff delay of 100ms, got %s", delay)
+	}
+
+	// an error without a retry delay does not override the backoff
+	retryAfter.set(errors.New("throttled"))
+	if delay, _ := backoff.Next(); delay != 100*time.Millisecond {
+		t.Errorf("expected backoff delay of 100ms, got %s", delay)
+	}
+}
@@ -0,0 +1,130 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+// validate the explode hydrate config for the table
+func (t *Table) validateExplodeHydrate() []string {
+	var validationErrors []string
+	var explodeNames []string
+	for name, h := range t.hydrateConfigMap {
+		if h.Explode {
+			explodeNames = append(explodeNames, name)
+		}
+	}
+	if len(explodeNames) == 0 {
+		return nil
+	}
+	if len(explodeNames) > 1 {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' defines %d explode hydrate functions - only one is supported", t.Name, len(explodeNames)))
+	}
+
+	for _, explodeName := range explodeNames {
+		if t.Get != nil && t.Get.namedHydrate.Name == explodeName {
+			validationErrors = append(validationErrors, fmt.Sprintf("table '%s' Get hydrate function '%s' cannot be an explode hydrate", t.Name, explodeName))
+		}
+		if t.List != nil && (t.List.namedHydrate.Name == explodeName || t.List.getParentLevel(explodeName) != -1) {
+			validationErrors = append(validationErrors, fmt.Sprintf("table '%s' List hydrate function '%s' cannot be an explode hydrate", t.Name, explodeName))
+		}
+		for name, h := range t.hydrateConfigMap {
+			for _, dep := range h.Depends {
+				if newNamedHydrateFunc(dep).Name == explodeName {
+					validationErrors = append(validationErrors, fmt.Sprintf("table '%s' hydrate function '%s' depends on explode hydrate function '%s' - hydrate functions cannot depend on an explode hydrate", t.Name, name, explodeName))
+				}
+			}
+		}
+	}
+	return validationErrors
+}
+
+// getExplodeCall returns the explode hydrate call for the query, if one is required by the requested columns
+func (d *QueryData) getExplodeCall() *hydrateCall {
+	for _, h := range d.hydrateCalls {
+		if h.Config.Explode {
+			return h
+		}
+	}
+	return nil
+}
+
+// explode returns a rowData for each child item returned by the explode hydrate call
+// each child rowData inherits the parent item and hydrate results, with the explode hydrate result set to the child item
+func (r *rowData) explode(explodeName string) ([]*rowData, error) {
+	items, err := explodeItems(r.hydrateResults[explodeName])
+	if err != nil {
+		return nil, fmt.Errorf("table '%s' explode hydrate function '%s' %s", r.table.Name, explodeName, err.Error())
+	}
+
+	res := make([]*rowData, len(items))
+	for i, item := range items {
+		child := newRowData(r.queryData, r.item)
+		child.parentItem = r.parentItem
+		child.parentItems = r.parentItems
+		child.matrixItem = r.matrixItem
+		// NOTE: the child has its own hydrate errors map, as it is cleared when the child is released
+		for k, v := range r.hydrateErrors {
+			child.hydrateErrors[k] = v
+		}
+		for k, v := range r.hydrateResults {
+			child.hydrateResults[k] = v
+		}
+		child.hydrateResults[explodeName] = item
+		res[i] = child
+	}
+	return res, nil
+}
+
+// explodeItems converts the result of an explode hydrate call into a slice of child items
+func explodeItems(result interface{}) ([]interface{}, error) {
+	// a nil result (e.g. an ignored error) has no items
+	if result == nil {
+		return nil, nil
+	}
+	if items, ok := result.([]interface{}); ok {
+		return items, nil
+	}
+	val := reflect.ValueOf(result)
+	if val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return nil, nil
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return nil, fmt.Errorf("returned %T - explode hydrate functions must return a slice", result)
+	}
+	items := make([]interface{}, val.Len())
+	for i := range items {
+		items[i] = val.Index(i).Interface()
+	}
+	return items, nil
+}
+
+// getExplodedColumnValues generates the column values for each child row of an explode hydrate call
+func (r *rowData) getExplodedColumnValues(ctx context.Context, explodeName string) ([]*proto.Row, error) {
+	children, err := r.explode(explodeName)
+	if err != nil {
+		return nil, err
+	}
+	// the children are no longer needed once their rows are built - return them to the pool
+	defer func() {
+		for _, child := range children {
+			child.release()
+		}
+	}()
+
+	rows := make([]*proto.Row, len(children))
+	for i, child := range children {
+		row, err := child.getColumnValues(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
@@ -0,0 +1,158 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExplodeItems(t *testing.T) {
+	type rule struct{ Id string }
+	rules := []*rule{{Id: "r1"}, {Id: "r2"}}
+
+	testCases := map[string]struct {
+		result   interface{}
+		expected []interface{}
+		err      bool
+	}{
+		"nil":               {result: nil, expected: nil},
+		"interface slice":   {result: []interface{}{"a", "b"}, expected: []interface{}{"a", "b"}},
+		"typed slice":       {result: rules, expected: []interface{}{rules[0], rules[1]}},
+		"pointer to slice":  {result: &[]string{"a"}, expected: []interface{}{"a"}},
+		"nil slice pointer": {result: (*[]string)(nil), expected: nil},
+		"not a slice":       {result: "a", err: true},
+	}
+	for name, test := range testCases {
+		items, err := explodeItems(test.result)
+		if test.err {
+			if err == nil {
+				t.Errorf("test %s: expected error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %s: unexpected error %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(items, test.expected) {
+			t.Errorf("test %s: expected %v, got %v", name, test.expected, items)
+		}
+	}
+}
+
+func TestRowDataExplode(t *testing.T) {
+	d := &QueryData{Table: &Table{Name: "test"}}
+	r := newRowData(d, "parent")
+	r.set("hydrate1", "parent hydrate")
+	r.set("hydrate2", []string{"c1", "c2", "c3"})
+
+	children, err := r.explode("hydrate2")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(children) != 3 {
+		t.Fatalf("expected 3 child rows, got %d", len(children))
+	}
+	for i, child := range children {
+		if child.item != "parent" {
+			t.Errorf("child %d: expected parent item, got %v", i, child.item)
+		}
+		if child.hydrateResults["hydrate1"] != "parent hydrate" {
+			t.Errorf("child %d: expected to inherit parent hydrate results, got %v", i, child.hydrateResults["hydrate1"])
+		}
+		if expected := []string{"c1", "c2", "c3"}[i]; child.hydrateResults["hydrate2"] != expected {
+			t.Errorf("child %d: expected explode result %s, got %v", i, expected, child.hydrateResults["hydrate2"])
+		}
+	}
+	// the parent row must be unchanged
+	if _, ok := r.hydrateResults["hydrate2"].([]string); !ok {
+		t.Errorf("expected parent explode result to be unchanged")
+	}
+
+	// each child has its own hydrate errors, so releasing a child does not affect the parent
+	r.setError("hydrate3", errors.New("failed"))
+	children, _ = r.explode("hydrate2")
+	if children[0].hydrateErrors["hydrate3"] == nil {
+		t.Errorf("expected child to inherit parent hydrate errors")
+	}
+	children[0].release()
+	if r.hydrateErrors["hydrate3"] == nil {
+		t.Errorf("expected parent hydrate errors to be unchanged when a child is released")
+	}
+}
+
+func TestExplodedRowLimit(t *testing.T) {
+	limit := int64(3)
+	s := newQueryStatus(&limit)
+	s.applyLimitToExplodedRows()
+
+	// the limit does not apply to the items streamed
+	if remaining := s.RowsRemaining(context.Background()); remaining != math.MaxInt32 {
+		t.Errorf("expected %d rows remaining, got %d", math.MaxInt32, remaining)
+	}
+	var sent int
+	for i := 0; i < 5; i++ {
+		if s.onRowSent() {
+			sent++
+		}
+	}
+	if sent != 3 {
+		t.Errorf("expected 3 rows to be sent, got %d", sent)
+	}
+	// once the limit is reached for the exploded rows, no more items are required
+	if remaining := s.RowsRemaining(context.Background()); remaining != 0 {
+		t.Errorf("expected 0 rows remaining, got %d", remaining)
+	}
+}
+
+func TestValidateExplodeHydrate(t *testing.T) {
+	testCases := map[string]struct {
+		table    *Table
+		expected string
+	}{
+		"valid": {
+			table: &Table{
+				HydrateConfig: []HydrateConfig{{Func: hydrate1, Explode: true}},
+			},
+		},
+		"multiple explode": {
+			table: &Table{
+				HydrateConfig: []HydrateConfig{{Func: hydrate1, Explode: true}, {Func: hydrate2, Explode: true}},
+			},
+			expected: "table 'table' defines 2 explode hydrate functions - only one is supported",
+		},
+		"depends on explode": {
+			table: &Table{
+				HydrateConfig: []HydrateConfig{{Func: hydrate1, Explode: true}, {Func: hydrate2, Depends: []HydrateFunc{hydrate1}}},
+			},
+			expected: "table 'table' hydrate function 'hydrate2' depends on explode hydrate function 'hydrate1' - hydrate functions cannot depend on an explode hydrate",
+		},
+		"list explode": {
+			table: &Table{
+				List:          &ListConfig{Hydrate: listHydrate},
+				HydrateConfig: []HydrateConfig{{Func: listHydrate, Explode: true}},
+			},
+			expected: "table 'table' List hydrate function 'listHydrate' cannot be an explode hydrate",
+		},
+	}
+	for name, test := range testCases {
+		table := test.table
+		table.Name = "table"
+		table.Plugin = &Plugin{Name: "plugin"}
+		table.initialise(table.Plugin)
+
+		errs := table.validateExplodeHydrate()
+		if test.expected == "" {
+			if len(errs) != 0 {
+				t.Errorf("test %s: expected no errors, got %v", name, errs)
+			}
+			continue
+		}
+		if !strings.Contains(strings.Join(errs, "\n"), test.expected) {
+			t.Errorf("test %s: expected error '%s', got %v", name, test.expected, errs)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -105,3 +106,14 @@ func (c *IgnoreConfig) DefaultTo(other *IgnoreConfig) {
 		c.ShouldIgnoreErrorFunc = other.ShouldIgnoreErrorFunc
 	}
 }
+
+// shouldIgnoreError returns whether the given error should be ignored, using either ShouldIgnoreError or ShouldIgnoreErrorFunc
+func (c *IgnoreConfig) shouldIgnoreError(ctx context.Context, d *QueryData, h *HydrateData, err error) bool {
+	if c.ShouldIgnoreError != nil && c.ShouldIgnoreError(err) {
+		return true
+	}
+	if c.ShouldIgnoreErrorFunc != nil && c.ShouldIgnoreErrorFunc(ctx, d, h, err) {
+		return true
+	}
+	return false
+}
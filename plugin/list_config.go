@@ -2,9 +2,11 @@ package plugin
 
 import (
 	"fmt"
+	"log"
+	"time"
+
 	"github.com/gertd/go-pluralize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
-	"log"
 )
 
 /*
@@ -29,6 +31,22 @@ To define a table's List function:
 		}
 	}
 
+To list items with a multi-level parent-child relationship (e.g. org → project → repo → branch),
+specify a chain of parent list functions, starting with the outermost:
+
+	List: &plugin.ListConfig{
+		ParentChain: []*plugin.ParentListConfig{
+			{Hydrate: listOrgs},
+			{Hydrate: listProjects, KeyColumns: plugin.OptionalColumns([]string{"project"}), MaxConcurrency: 10},
+			{Hydrate: listRepos, Tags: map[string]string{"service": "repos"}},
+		},
+		Hydrate: listBranches,
+	}
+
+Each parent list function streams its items using [plugin.QueryData.StreamListItem], and the next function in the chain
+is called for each item. The items of all levels are available to each function (and to all column hydrate functions)
+in [plugin.HydrateData.ParentItems].
+
 Examples:
   - [hackernews]
 
@@ -41,10 +59,20 @@ type ListConfig struct {
 	KeyColumns KeyColumnSlice
 	// the parent list function - if we list items with a parent-child relationship, this will list the parent items
 	ParentHydrate HydrateFunc
+	// a chain of parent list functions, starting with the outermost - used for multi-level parent-child relationships
+	// NOTE: only one of ParentHydrate and ParentChain may be set
+	ParentChain []*ParentListConfig
 	// a function which will return whenther to ignore a given error
 	IgnoreConfig *IgnoreConfig
 	// a function which will return whenther to retry the call if an error is returned
 	RetryConfig *RetryConfig
+	// fail fast when the list function is repeatedly failing
+	CircuitBreakerConfig *CircuitBreakerConfig
+	// the timeout for each call of the list function (and the parent list function, if defined)
+	// - defaults to the table DefaultTimeout
+	// NOTE: the list call does not complete until all items have been streamed, so this must allow for paging
+	// NOTE: the timeout is enforced using the context deadline, so the list function must respect context cancellation
+	Timeout time.Duration
 
 	Tags       map[string]string
 	ParentTags map[string]string
@@ -54,6 +82,10 @@ type ListConfig struct {
 
 	namedHydrate       namedHydrateFunc
 	namedParentHydrate namedHydrateFunc
+	// the parent list calls - either ParentChain, or a single level built from ParentHydrate
+	parentChain []*ParentListConfig
+	// the list key columns, including the key columns of each level of the ParentChain (nil if there is no ParentChain)
+	keyColumns KeyColumnSlice
 }
 
 func (c *ListConfig) initialise(table *Table) {
@@ -85,6 +117,17 @@ func (c *ListConfig) initialise(table *Table) {
 	// default ignore and retry configs to table defaults
 	c.RetryConfig.DefaultTo(table.DefaultRetryConfig)
 	c.IgnoreConfig.DefaultTo(table.DefaultIgnoreConfig)
+	// default circuit breaker config to plugin default
+	if c.CircuitBreakerConfig == nil {
+		c.CircuitBreakerConfig = table.Plugin.DefaultCircuitBreakerConfig
+	}
+	if c.CircuitBreakerConfig != nil {
+		c.CircuitBreakerConfig.initialise()
+	}
+	// default timeout to table default
+	if c.Timeout == 0 {
+		c.Timeout = table.DefaultTimeout
+	}
 
 	// populate the named hydrate funcs
 	c.namedHydrate = newNamedHydrateFunc(c.Hydrate)
@@ -96,6 +139,41 @@ func (c *ListConfig) initialise(table *Table) {
 
 		// add in parent function name to tags
 		c.ParentTags[rate_limiter.RateLimiterScopeFunction] = c.namedParentHydrate.Name
+
+		// the parent hydrate is a single level chain, using the list config
+		c.parentChain = []*ParentListConfig{{
+			Hydrate:              c.ParentHydrate,
+			IgnoreConfig:         c.IgnoreConfig,
+			RetryConfig:          c.RetryConfig,
+			CircuitBreakerConfig: c.CircuitBreakerConfig,
+			Timeout:              c.Timeout,
+			Tags:                 c.ParentTags,
+			namedHydrate:         c.namedParentHydrate,
+		}}
+	} else if len(c.ParentChain) > 0 {
+		c.parentChain = nil
+		for _, p := range c.ParentChain {
+			// nil items are reported by Validate
+			if p == nil {
+				continue
+			}
+			p.initialise(c, table)
+			c.parentChain = append(c.parentChain, p)
+		}
+		// add in any parent key columns which are not already list key columns, so the quals are passed to the plugin
+		// NOTE: build a new slice, rather than modifying the KeyColumns of the config
+		c.keyColumns = append(KeyColumnSlice{}, c.KeyColumns...)
+		for _, p := range c.parentChain {
+			for _, k := range p.KeyColumns {
+				if c.keyColumns.Find(k.Name) == nil {
+					c.keyColumns = append(c.keyColumns, k)
+				}
+			}
+		}
+		// for backwards compatibility, populate the named parent hydrate with the outermost parent
+		if len(c.parentChain) > 0 {
+			c.namedParentHydrate = c.parentChain[0].namedHydrate
+		}
 	}
 
 	log.Printf("[TRACE] ListConfig.initialise complete: RetryConfig: %s, IgnoreConfig %s", c.RetryConfig.String(), c.IgnoreConfig.String())
@@ -112,6 +190,20 @@ func (c *ListConfig) Validate(table *Table) []string {
 	if c.IgnoreConfig != nil {
 		validationErrors = append(validationErrors, c.IgnoreConfig.validate(table)...)
 	}
+	if c.CircuitBreakerConfig != nil {
+		validationErrors = append(validationErrors, c.CircuitBreakerConfig.validate(table)...)
+	}
+	validationErrors = append(validationErrors, validateTimeout(c.Timeout, "ListConfig Timeout", table)...)
+	if c.ParentHydrate != nil && len(c.ParentChain) > 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' ListConfig defines both ParentHydrate and ParentChain", table.Name))
+	}
+	for i, p := range c.ParentChain {
+		if p == nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("table '%s' ListConfig ParentChain item %d is nil", table.Name, i))
+			continue
+		}
+		validationErrors = append(validationErrors, p.validate(table)...)
+	}
 
 	// ensure that if there is an explicit hydrate config for the list hydrate, it does not declare dependencies
 	listHydrateName := table.List.namedHydrate.Name
@@ -138,3 +230,32 @@ func (c *ListConfig) Validate(table *Table) []string {
 
 	return validationErrors
 }
+
+// getListCallConfig returns the ignore config, retry config and timeout for the top level list call
+// - if there is a parent list chain, this is the outermost parent list function
+func (c *ListConfig) getListCallConfig() (*IgnoreConfig, *RetryConfig, time.Duration) {
+	if len(c.parentChain) > 0 {
+		parent := c.parentChain[0]
+		return parent.IgnoreConfig, parent.RetryConfig, parent.Timeout
+	}
+	return c.IgnoreConfig, c.RetryConfig, c.Timeout
+}
+
+// getKeyColumns returns the key columns used for the list call
+// - this includes the key columns of each level of the ParentChain
+func (c *ListConfig) getKeyColumns() KeyColumnSlice {
+	if c.keyColumns != nil {
+		return c.keyColumns
+	}
+	return c.KeyColumns
+}
+
+// getParentLevel returns the level of the given function in the parent chain (-1 if it is not a parent list function)
+func (c *ListConfig) getParentLevel(funcName string) int {
+	for i, p := range c.parentChain {
+		if p.namedHydrate.Name == funcName {
+			return i
+		}
+	}
+	return -1
+}
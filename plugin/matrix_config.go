@@ -0,0 +1,220 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/error_helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"golang.org/x/exp/maps"
+	"golang.org/x/sync/semaphore"
+)
+
+// MatrixFailurePolicy determines how an error fetching a single matrix item is handled
+type MatrixFailurePolicy string
+
+const (
+	// MatrixFailureFail fails the query if any matrix item fails (the default)
+	MatrixFailureFail MatrixFailurePolicy = "fail"
+	// MatrixFailureWarn skips the failed matrix item and returns the error as a query warning
+	MatrixFailureWarn MatrixFailurePolicy = "warn"
+	// MatrixFailureIgnore skips the failed matrix item - the error is only logged
+	MatrixFailureIgnore MatrixFailurePolicy = "ignore"
+)
+
+/*
+MatrixConfig defines how the get and list calls are executed for each [matrix item]:
+
+  - How to handle an error for a single matrix item: [plugin.MatrixConfig.FailurePolicy].
+
+  - How many matrix items may be fetched concurrently: [plugin.MatrixConfig.MaxConcurrency].
+
+For example, to skip regions which are not enabled, returning the error as a query warning,
+and to fetch at most 5 regions at a time:
+
+	Table{
+		Name:         "aws_ec2_instance",
+		GetMatrixItemFunc: SupportedRegionMatrix,
+		MatrixConfig: &plugin.MatrixConfig{
+			FailurePolicy:  plugin.MatrixFailureWarn,
+			MaxConcurrency: 5,
+		},
+	}
+
+A MatrixConfig may be set for all tables using [plugin.Plugin.DefaultMatrixConfig].
+
+NOTE: if a matrix item fails after some of its rows have been streamed, those rows are still returned.
+The status of each matrix item (whether it succeeded, and the error if not) is returned in the query metadata.
+The failed matrix items are also reported in the `failed_matrix_items` property of the `_ctx` column of subsequent rows.
+
+NOTE: the failure policy applies to the get or list call for each matrix item. For a parent-child list,
+errors from the child list calls are not subject to the failure policy.
+
+[matrix item]: https://steampipe.io/docs/develop/writing-plugins#matrix-items
+*/
+type MatrixConfig struct {
+	// how to handle an error fetching a matrix item - defaults to MatrixFailureFail
+	FailurePolicy MatrixFailurePolicy
+	// the maximum number of matrix items fetched concurrently - if zero, there is no limit
+	MaxConcurrency int
+}
+
+func (c *MatrixConfig) initialise() {
+	if c.FailurePolicy == "" {
+		c.FailurePolicy = MatrixFailureFail
+	}
+}
+
+// DefaultTo sets all unset values to the values in other
+func (c *MatrixConfig) DefaultTo(other *MatrixConfig) {
+	if other == nil {
+		return
+	}
+	if c.FailurePolicy == "" {
+		c.FailurePolicy = other.FailurePolicy
+	}
+	if c.MaxConcurrency == 0 {
+		c.MaxConcurrency = other.MaxConcurrency
+	}
+}
+
+func (c *MatrixConfig) validate(table *Table) []string {
+	var validationErrors []string
+	var prefix string
+	if table != nil {
+		prefix = fmt.Sprintf("table '%s': ", table.Name)
+	}
+	switch c.FailurePolicy {
+	case "", MatrixFailureFail, MatrixFailureWarn, MatrixFailureIgnore:
+	default:
+		validationErrors = append(validationErrors, fmt.Sprintf("%sMatrixConfig FailurePolicy '%s' is invalid - must be one of '%s', '%s' or '%s'", prefix, c.FailurePolicy, MatrixFailureFail, MatrixFailureWarn, MatrixFailureIgnore))
+	}
+	if c.MaxConcurrency < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("%sMatrixConfig MaxConcurrency cannot be negative", prefix))
+	}
+	return validationErrors
+}
+
+// newSemaphore returns a semaphore limiting the number of matrix items fetched concurrently (nil if there is no limit)
+func (c *MatrixConfig) newSemaphore() *semaphore.Weighted {
+	if c == nil || c.MaxConcurrency <= 0 {
+		return nil
+	}
+	return semaphore.NewWeighted(int64(c.MaxConcurrency))
+}
+
+// matrixItemStatuses records the result of fetching each matrix item, keyed by matrix item
+type matrixItemStatuses struct {
+	statuses map[string]*matrixItemStatus
+	mut      sync.Mutex
+}
+
+type matrixItemStatus struct {
+	matrixItem map[string]interface{}
+	err        error
+}
+
+func newMatrixItemStatuses() *matrixItemStatuses {
+	return &matrixItemStatuses{statuses: make(map[string]*matrixItemStatus)}
+}
+
+// add records the result of fetching the matrix item
+// (a matrix item may be fetched more than once, e.g. for each key value of a get call - if any fetch failed, the item failed)
+func (s *matrixItemStatuses) add(matrixItem map[string]interface{}, err error) {
+	key := fmt.Sprintf("%v", matrixItem)
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if status, ok := s.statuses[key]; ok {
+		if status.err == nil {
+			status.err = err
+		}
+		return
+	}
+	s.statuses[key] = &matrixItemStatus{matrixItem: matrixItem, err: err}
+}
+
+// failed returns the matrix items which failed, sorted by matrix item
+func (s *matrixItemStatuses) failed() []map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	var res []map[string]interface{}
+	for _, status := range s.sorted() {
+		if status.err != nil {
+			res = append(res, status.matrixItem)
+		}
+	}
+	return res
+}
+
+// toProto returns the status of each matrix item, sorted by matrix item
+func (s *matrixItemStatuses) toProto() []*proto.MatrixItemStatus {
+	if s == nil {
+		return nil
+	}
+	var res []*proto.MatrixItemStatus
+	for _, status := range s.sorted() {
+		protoStatus := &proto.MatrixItemStatus{
+			MatrixItem: make(map[string]string, len(status.matrixItem)),
+			Success:    status.err == nil,
+		}
+		for k, v := range status.matrixItem {
+			protoStatus.MatrixItem[k] = fmt.Sprintf("%v", v)
+		}
+		if status.err != nil {
+			protoStatus.Error = status.err.Error()
+		}
+		res = append(res, protoStatus)
+	}
+	return res
+}
+
+func (s *matrixItemStatuses) sorted() []*matrixItemStatus {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	keys := maps.Keys(s.statuses)
+	sort.Strings(keys)
+	res := make([]*matrixItemStatus, len(keys))
+	for i, key := range keys {
+		status := *s.statuses[key]
+		res[i] = &status
+	}
+	return res
+}
+
+// onMatrixItemFetched records the result of the get or list call for a matrix item,
+// and applies the table matrix failure policy to any error
+// if the error is tolerated, nil is returned
+//
+// NOTE: this must only be called for the top level get or list call of each matrix item
+// - errors from child list calls (of a parent-child list) are not subject to the failure policy
+func (d *QueryData) onMatrixItemFetched(ctx context.Context, funcName string, err error) error {
+	matrixItem := getQueryMatrixItem(ctx, d)
+	if len(matrixItem) == 0 {
+		return err
+	}
+	if d.queryStatus != nil {
+		d.queryStatus.matrixItems.add(matrixItem, err)
+	}
+	if err == nil || d.Table.MatrixConfig == nil {
+		return err
+	}
+	// never tolerate cancellation or a query timeout
+	if ctx.Err() != nil || error_helpers.IsContextCancelledError(err) || IsQueryTimeoutError(err) {
+		return err
+	}
+
+	switch d.Table.MatrixConfig.FailurePolicy {
+	case MatrixFailureWarn:
+		log.Printf("[WARN] table '%s' matrix item %v failed - adding query warning: %s (%s)", d.Table.Name, matrixItem, err.Error(), d.connectionCallId)
+		d.addWarning(ctx, funcName, err)
+	case MatrixFailureIgnore:
+		log.Printf("[INFO] table '%s' matrix item %v failed - ignoring: %s (%s)", d.Table.Name, matrixItem, err.Error(), d.connectionCallId)
+	default:
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestMatrixQueryData(policy MatrixFailurePolicy) *QueryData {
+	return &QueryData{
+		Table:       &Table{Name: "test", MatrixConfig: &MatrixConfig{FailurePolicy: policy}},
+		Connection:  &Connection{Name: "c1"},
+		queryStatus: newQueryStatus(nil),
+		matrixItem:  map[string]interface{}{"region": "us-east-1"},
+	}
+}
+
+func TestOnMatrixItemFetched(t *testing.T) {
+	testErr := errors.New("region not enabled")
+
+	testCases := map[string]struct {
+		policy         MatrixFailurePolicy
+		expectError    bool
+		expectWarnings int
+	}{
+		"fail":   {policy: MatrixFailureFail, expectError: true},
+		"warn":   {policy: MatrixFailureWarn, expectWarnings: 1},
+		"ignore": {policy: MatrixFailureIgnore},
+	}
+	for name, test := range testCases {
+		d := newTestMatrixQueryData(test.policy)
+		err := d.onMatrixItemFetched(context.Background(), "listInstances", testErr)
+		if test.expectError != (err != nil) {
+			t.Errorf("test %s: expected error %v, got %v", name, test.expectError, err)
+		}
+		if warnings, _ := d.queryStatus.warnings.toProto(); len(warnings) != test.expectWarnings {
+			t.Errorf("test %s: expected %d warnings, got %d", name, test.expectWarnings, len(warnings))
+		}
+		// the failure is recorded once, whatever the policy
+		d.onMatrixItemFetched(context.Background(), "listInstances", testErr)
+		if failed := d.queryStatus.matrixItems.failed(); len(failed) != 1 || failed[0]["region"] != "us-east-1" {
+			t.Errorf("test %s: expected failed matrix item to be recorded once, got %v", name, failed)
+		}
+	}
+}
+
+func TestOnMatrixItemFetchedCancelled(t *testing.T) {
+	d := newTestMatrixQueryData(MatrixFailureIgnore)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := d.onMatrixItemFetched(ctx, "listInstances", context.Canceled); err == nil {
+		t.Errorf("expected cancellation error not to be ignored")
+	}
+}
+
+func TestMatrixItemStatuses(t *testing.T) {
+	d := newTestMatrixQueryData(MatrixFailureWarn)
+	d.onMatrixItemFetched(context.Background(), "listInstances", nil)
+	failedQueryData := newTestMatrixQueryData(MatrixFailureWarn)
+	failedQueryData.queryStatus = d.queryStatus
+	failedQueryData.matrixItem = map[string]interface{}{"region": "eu-west-1"}
+	failedQueryData.onMatrixItemFetched(context.Background(), "listInstances", errors.New("region not enabled"))
+
+	// the status of every matrix item is returned in the final query metadata
+	statuses := d.getQueryMetadata(true).MatrixItems
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 matrix item statuses, got %v", statuses)
+	}
+	if statuses[0].MatrixItem["region"] != "eu-west-1" || statuses[0].Success || statuses[0].Error != "region not enabled" {
+		t.Errorf("expected eu-west-1 to have failed, got %v", statuses[0])
+	}
+	if statuses[1].MatrixItem["region"] != "us-east-1" || !statuses[1].Success || statuses[1].Error != "" {
+		t.Errorf("expected us-east-1 to have succeeded, got %v", statuses[1])
+	}
+	if statuses := d.getQueryMetadata(false).MatrixItems; len(statuses) != 0 {
+		t.Errorf("expected no matrix item statuses in non-final metadata, got %v", statuses)
+	}
+}
+
+func TestMatrixConfigDefaults(t *testing.T) {
+	p := &Plugin{Name: "test", DefaultMatrixConfig: &MatrixConfig{FailurePolicy: MatrixFailureWarn, MaxConcurrency: 5}}
+
+	table := &Table{Name: "t1", MatrixConfig: &MatrixConfig{MaxConcurrency: 2}}
+	table.initialise(p)
+	if table.MatrixConfig.FailurePolicy != MatrixFailureWarn || table.MatrixConfig.MaxConcurrency != 2 {
+		t.Errorf("expected table MatrixConfig to default to plugin DefaultMatrixConfig, got %+v", table.MatrixConfig)
+	}
+
+	table = &Table{Name: "t2"}
+	table.initialise(&Plugin{Name: "test"})
+	if table.MatrixConfig.FailurePolicy != MatrixFailureFail {
+		t.Errorf("expected default FailurePolicy '%s', got '%s'", MatrixFailureFail, table.MatrixConfig.FailurePolicy)
+	}
+
+	errs := (&MatrixConfig{FailurePolicy: "skip", MaxConcurrency: -1}).validate(table)
+	if len(errs) != 2 || !strings.Contains(errs[0], "FailurePolicy 'skip' is invalid") {
+		t.Errorf("expected 2 validation errors, got %v", errs)
+	}
+}
@@ -0,0 +1,146 @@
+package plugin
+
+import (
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+)
+
+// the qual operators which are evaluated against the matrix values to filter the matrix items
+var matrixFilterOperators = []string{
+	quals.QualOperatorEqual,
+	quals.QualOperatorNotEqual,
+	quals.QualOperatorLike,
+	quals.QualOperatorNotLike,
+	quals.QualOperatorILike,
+	quals.QualOperatorNotILike,
+	quals.QualOperatorRegex,
+	quals.QualOperatorNotRegex,
+	quals.QualOperatorIRegex,
+	quals.QualOperatorNotIRegex,
+}
+
+// matrixValueSatisfiesQuals returns whether the matrix value satisfies all quals for the matrix column
+// NOTE: if a qual cannot be evaluated against the matrix value, it is treated as satisfied,
+// i.e. the matrix item is only excluded if we are sure the qual excludes it
+func matrixValueSatisfiesQuals(columnQuals quals.QualSlice, matrixVal interface{}) bool {
+	for _, q := range columnQuals {
+		if !matrixValueSatisfiesQual(q, matrixVal) {
+			log.Printf("[TRACE] matrix value %v does not satisfy qual %s %v", matrixVal, q.Operator, q.Value)
+			return false
+		}
+	}
+	return true
+}
+
+func matrixValueSatisfiesQual(q *quals.Qual, matrixVal interface{}) bool {
+	if q.Value == nil {
+		return true
+	}
+	// a negated operator must be satisfied for every value of a list (e.g. NOT IN),
+	// otherwise it must be satisfied for any value (e.g. IN)
+	operator, negated := matrixFilterBaseOperator(q.Operator)
+
+	values := []*proto.QualValue{q.Value}
+	if listValue := q.Value.GetListValue(); listValue != nil {
+		values = listValue.Values
+	}
+	for _, v := range values {
+		match, ok := matrixValueMatches(operator, v, matrixVal)
+		if !ok {
+			// we cannot evaluate this qual - assume it is satisfied
+			return true
+		}
+		if negated && match {
+			return false
+		}
+		if !negated && match {
+			return true
+		}
+	}
+	return negated
+}
+
+// matrixFilterBaseOperator returns the positive form of the operator, and whether the operator is negated
+func matrixFilterBaseOperator(operator string) (string, bool) {
+	switch operator {
+	case quals.QualOperatorNotEqual:
+		return quals.QualOperatorEqual, true
+	case quals.QualOperatorNotLike:
+		return quals.QualOperatorLike, true
+	case quals.QualOperatorNotILike:
+		return quals.QualOperatorILike, true
+	case quals.QualOperatorNotRegex:
+		return quals.QualOperatorRegex, true
+	case quals.QualOperatorNotIRegex:
+		return quals.QualOperatorIRegex, true
+	}
+	return operator, false
+}
+
+// matrixValueMatches evaluates the (positive) operator for a single qual value
+// ok is false if the operator cannot be evaluated
+func matrixValueMatches(operator string, qualValue *proto.QualValue, matrixVal interface{}) (match, ok bool) {
+	if operator == quals.QualOperatorEqual {
+		return grpc.GetQualValue(qualValue) == matrixVal, true
+	}
+
+	// the pattern operators can only be evaluated for string values
+	pattern, isString := grpc.GetQualValue(qualValue).(string)
+	val, valIsString := matrixVal.(string)
+	if !isString || !valIsString {
+		return false, false
+	}
+
+	var re *regexp.Regexp
+	var err error
+	switch operator {
+	case quals.QualOperatorLike:
+		re, err = likePatternToRegexp(pattern, false)
+	case quals.QualOperatorILike:
+		re, err = likePatternToRegexp(pattern, true)
+	case quals.QualOperatorRegex:
+		re, err = regexp.Compile(pattern)
+	case quals.QualOperatorIRegex:
+		re, err = regexp.Compile("(?i)" + pattern)
+	default:
+		return false, false
+	}
+	if err != nil {
+		log.Printf("[TRACE] failed to compile pattern '%s' for operator %s: %s", pattern, operator, err.Error())
+		return false, false
+	}
+	return re.MatchString(val), true
+}
+
+// likePatternToRegexp converts a SQL LIKE pattern into an anchored regular expression
+// '%' matches any sequence of characters, '_' matches any single character and '\' escapes the next character
+func likePatternToRegexp(pattern string, caseInsensitive bool) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	if caseInsensitive {
+		sb.WriteString("(?i)")
+	}
+	sb.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString("(?s:.*)")
+		case r == '_':
+			sb.WriteString("(?s:.)")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
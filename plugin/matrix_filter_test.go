@@ -0,0 +1,179 @@
+package plugin
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+)
+
+func stringQualValue(s string) *proto.QualValue {
+	return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: s}}
+}
+
+func stringListQualValue(values ...string) *proto.QualValue {
+	list := &proto.QualValueList{}
+	for _, s := range values {
+		list.Values = append(list.Values, stringQualValue(s))
+	}
+	return &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: list}}
+}
+
+func stringQual(column, operator string, value *proto.QualValue) *proto.Qual {
+	return &proto.Qual{FieldName: column, Operator: &proto.Qual_StringValue{StringValue: operator}, Value: value}
+}
+
+func newTestMatrixFilterQueryData(unsafeQuals map[string]*proto.Quals) *QueryData {
+	return &QueryData{
+		Matrix: []map[string]interface{}{
+			{"region": "us-east-1", "account": "a1"},
+			{"region": "us-east-2", "account": "a1"},
+			{"region": "us-west-1", "account": "a2"},
+			{"region": "eu-west-1", "account": "a2"},
+		},
+		QueryContext: &QueryContext{UnsafeQuals: unsafeQuals},
+		Quals:        KeyColumnQualMap{},
+	}
+}
+
+func TestFilterMatrixItems(t *testing.T) {
+	testCases := map[string]struct {
+		quals           []*proto.Qual
+		expectedRegions []string
+		expectedColumns []string
+	}{
+		"no quals": {
+			expectedRegions: []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1"},
+		},
+		"equals": {
+			quals:           []*proto.Qual{stringQual("region", "=", stringQualValue("us-west-1"))},
+			expectedRegions: []string{"us-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"in": {
+			quals:           []*proto.Qual{stringQual("region", "=", stringListQualValue("us-east-1", "eu-west-1"))},
+			expectedRegions: []string{"us-east-1", "eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"not equal": {
+			quals:           []*proto.Qual{stringQual("region", "<>", stringQualValue("us-east-1"))},
+			expectedRegions: []string{"us-east-2", "us-west-1", "eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"not in": {
+			quals:           []*proto.Qual{stringQual("region", "<>", stringListQualValue("us-east-1", "us-east-2"))},
+			expectedRegions: []string{"us-west-1", "eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"like": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorLike, stringQualValue("us-%"))},
+			expectedRegions: []string{"us-east-1", "us-east-2", "us-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"like single character": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorLike, stringQualValue("us-east-_"))},
+			expectedRegions: []string{"us-east-1", "us-east-2"},
+			expectedColumns: []string{"region"},
+		},
+		"like is case sensitive": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorLike, stringQualValue("US-%"))},
+			expectedColumns: []string{"region"},
+		},
+		"ilike": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorILike, stringQualValue("US-%"))},
+			expectedRegions: []string{"us-east-1", "us-east-2", "us-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"not like": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorNotLike, stringQualValue("%-east-%"))},
+			expectedRegions: []string{"us-west-1", "eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"regex": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorRegex, stringQualValue("west"))},
+			expectedRegions: []string{"us-west-1", "eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"iregex": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorIRegex, stringQualValue("^EU"))},
+			expectedRegions: []string{"eu-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"not regex": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorNotRegex, stringQualValue("-1$"))},
+			expectedRegions: []string{"us-east-2"},
+			expectedColumns: []string{"region"},
+		},
+		"invalid regex is not used to filter": {
+			quals:           []*proto.Qual{stringQual("region", quals.QualOperatorRegex, stringQualValue("(us"))},
+			expectedRegions: []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1"},
+		},
+		"multiple quals for a column": {
+			quals: []*proto.Qual{
+				stringQual("region", quals.QualOperatorLike, stringQualValue("us-%")),
+				stringQual("region", "<>", stringQualValue("us-east-2")),
+			},
+			expectedRegions: []string{"us-east-1", "us-west-1"},
+			expectedColumns: []string{"region"},
+		},
+		"multiple columns": {
+			quals: []*proto.Qual{
+				stringQual("region", quals.QualOperatorLike, stringQualValue("%west%")),
+				stringQual("account", "=", stringQualValue("a2")),
+			},
+			expectedRegions: []string{"us-west-1", "eu-west-1"},
+			expectedColumns: []string{"account", "region"},
+		},
+		"quals which do not filter are not recorded": {
+			quals: []*proto.Qual{
+				stringQual("region", quals.QualOperatorLike, stringQualValue("%")),
+				stringQual("account", "=", stringQualValue("a1")),
+			},
+			expectedRegions: []string{"us-east-1", "us-east-2"},
+			expectedColumns: []string{"account"},
+		},
+	}
+
+	for name, test := range testCases {
+		unsafeQuals := map[string]*proto.Quals{}
+		for _, q := range test.quals {
+			if unsafeQuals[q.FieldName] == nil {
+				unsafeQuals[q.FieldName] = &proto.Quals{}
+			}
+			unsafeQuals[q.FieldName].Quals = append(unsafeQuals[q.FieldName].Quals, q)
+		}
+		d := newTestMatrixFilterQueryData(unsafeQuals)
+		d.filterMatrixItems()
+
+		var regions []string
+		for _, m := range d.filteredMatrix {
+			regions = append(regions, m["region"].(string))
+		}
+		if !reflect.DeepEqual(regions, test.expectedRegions) {
+			t.Errorf("test %s: expected regions %v, got %v", name, test.expectedRegions, regions)
+		}
+		if (len(d.filteredMatrixColumns) > 0 || len(test.expectedColumns) > 0) && !reflect.DeepEqual(d.filteredMatrixColumns, test.expectedColumns) {
+			t.Errorf("test %s: expected filtered columns %v, got %v", name, test.expectedColumns, d.filteredMatrixColumns)
+		}
+	}
+}
+
+func TestGetCacheQualMapIncludesMatrixFilterQuals(t *testing.T) {
+	equalsQual := stringQual("region", "=", stringListQualValue("us-east-1", "us-west-1"))
+	likeQual := stringQual("region", quals.QualOperatorLike, stringQualValue("%east%"))
+	d := newTestMatrixFilterQueryData(map[string]*proto.Quals{
+		"region": {Quals: []*proto.Qual{equalsQual, likeQual}},
+	})
+	// region is also a key column, but only for the '=' operator
+	d.Quals = NewKeyColumnQualValueMap(d.QueryContext.UnsafeQuals, KeyColumnSlice{{Name: "region", Operators: []string{"="}}})
+	d.filterMatrixItems()
+
+	if len(d.filteredMatrix) != 1 || d.filteredMatrix[0]["region"] != "us-east-1" {
+		t.Fatalf("expected only us-east-1 to be included, got %v", d.filteredMatrix)
+	}
+	cacheQuals := d.getCacheQualMap()["region"]
+	if cacheQuals == nil || len(cacheQuals.Quals) != 2 {
+		t.Errorf("expected cache qual map to include both region quals, got %v", cacheQuals)
+	}
+}
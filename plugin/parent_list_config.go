@@ -0,0 +1,134 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+	"golang.org/x/sync/semaphore"
+)
+
+/*
+ParentListConfig defines a single level of a chain of parent list functions: [plugin.ListConfig.ParentChain].
+
+Each level has its own [plugin.ParentListConfig.KeyColumns], error handling, rate limiter tags and concurrency limit:
+
+	List: &plugin.ListConfig{
+		ParentChain: []*plugin.ParentListConfig{
+			{
+				Hydrate:    listProjects,
+				KeyColumns: plugin.OptionalColumns([]string{"project"}),
+				Tags:       map[string]string{"service": "projects"},
+			},
+			{
+				Hydrate:        listRepos,
+				MaxConcurrency: 10,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: shouldIgnoreError},
+			},
+		},
+		Hydrate: listBranches,
+	}
+
+The KeyColumns of each level are added to the list key columns, so the quals are passed to the plugin,
+and the level's hydrate function can use them (via [plugin.QueryData.EqualsQuals]) to restrict the items it lists.
+*/
+type ParentListConfig struct {
+	// the parent list function - this should stream the parent items using the QueryData object and return nil
+	Hydrate HydrateFunc
+	// key columns whose quals are used by this parent list function
+	KeyColumns KeyColumnSlice
+	// a function which will return whenther to ignore a given error - defaults to the list IgnoreConfig
+	IgnoreConfig *IgnoreConfig
+	// a function which will return whenther to retry the call if an error is returned - defaults to the list RetryConfig
+	RetryConfig *RetryConfig
+	// fail fast when the parent list function is repeatedly failing - defaults to the list CircuitBreakerConfig
+	CircuitBreakerConfig *CircuitBreakerConfig
+	// the timeout for each call of the parent list function - defaults to the list Timeout
+	Timeout time.Duration
+	// tags - used to resolve the rate limiter for this parent list call
+	Tags map[string]string
+	// the maximum number of concurrent calls of this parent list function (per query) - if zero, there is no limit
+	MaxConcurrency int
+
+	namedHydrate namedHydrateFunc
+}
+
+func (c *ParentListConfig) initialise(listConfig *ListConfig, table *Table) {
+	c.namedHydrate = newNamedHydrateFunc(c.Hydrate)
+	log.Printf("[TRACE] ParentListConfig.initialise func %s, table %s", c.namedHydrate.Name, table.Name)
+
+	if c.RetryConfig == nil {
+		c.RetryConfig = &RetryConfig{}
+	}
+	if c.IgnoreConfig == nil {
+		c.IgnoreConfig = &IgnoreConfig{}
+	}
+	// default ignore and retry configs to the list config
+	c.RetryConfig.DefaultTo(listConfig.RetryConfig)
+	c.IgnoreConfig.DefaultTo(listConfig.IgnoreConfig)
+	if c.CircuitBreakerConfig == nil {
+		c.CircuitBreakerConfig = listConfig.CircuitBreakerConfig
+	}
+	if c.CircuitBreakerConfig != nil {
+		c.CircuitBreakerConfig.initialise()
+	}
+	if c.Timeout == 0 {
+		c.Timeout = listConfig.Timeout
+	}
+
+	if c.Tags == nil {
+		c.Tags = make(map[string]string)
+	}
+	// add in function name to tags
+	c.Tags[rate_limiter.RateLimiterScopeFunction] = c.namedHydrate.Name
+}
+
+func (c *ParentListConfig) validate(table *Table) []string {
+	var validationErrors []string
+	if c.Hydrate == nil {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' ParentListConfig does not specify a hydrate function", table.Name))
+	}
+	if c.RetryConfig != nil {
+		validationErrors = append(validationErrors, c.RetryConfig.validate(table)...)
+	}
+	if c.IgnoreConfig != nil {
+		validationErrors = append(validationErrors, c.IgnoreConfig.validate(table)...)
+	}
+	if c.CircuitBreakerConfig != nil {
+		validationErrors = append(validationErrors, c.CircuitBreakerConfig.validate(table)...)
+	}
+	validationErrors = append(validationErrors, validateTimeout(c.Timeout, "ParentListConfig Timeout", table)...)
+	if c.MaxConcurrency < 0 {
+		validationErrors = append(validationErrors, fmt.Sprintf("table '%s' ParentListConfig MaxConcurrency cannot be negative", table.Name))
+	}
+	return validationErrors
+}
+
+// parentListSemaphores limits the number of concurrent calls of each level of a parent list chain
+type parentListSemaphores []*semaphore.Weighted
+
+func newParentListSemaphores(listConfig *ListConfig) parentListSemaphores {
+	if listConfig == nil || len(listConfig.parentChain) == 0 {
+		return nil
+	}
+	res := make(parentListSemaphores, len(listConfig.parentChain))
+	for i, p := range listConfig.parentChain {
+		if p.MaxConcurrency > 0 {
+			res[i] = semaphore.NewWeighted(int64(p.MaxConcurrency))
+		}
+	}
+	return res
+}
+
+// acquire waits until a call of the given level can start, and returns a function to release it
+func (s parentListSemaphores) acquire(ctx context.Context, level int) (func(), error) {
+	if level >= len(s) || s[level] == nil {
+		return func() {}, nil
+	}
+	if err := s[level].Acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+	return func() { s[level].Release(1) }, nil
+}
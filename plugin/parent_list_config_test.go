@@ -0,0 +1,188 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func listTestOrgs(ctx context.Context, d *QueryData, _ *HydrateData) (interface{}, error) {
+	d.StreamListItem(ctx, "org1", "org2")
+	return nil, nil
+}
+
+func listTestProjects(ctx context.Context, d *QueryData, h *HydrateData) (interface{}, error) {
+	org := h.Item.(string)
+	d.StreamListItem(ctx, org+"/p1", org+"/p2")
+	return nil, nil
+}
+
+func listTestRepos(ctx context.Context, d *QueryData, h *HydrateData) (interface{}, error) {
+	d.StreamListItem(ctx, h.Item.(string)+"/r1")
+	return nil, nil
+}
+
+func newTestParentChainQueryData(listConfig *ListConfig) *QueryData {
+	table := &Table{Name: "test", List: listConfig}
+	table.initialise(&Plugin{Name: "test"})
+	d := &QueryData{
+		Table:                table,
+		queryStatus:          newQueryStatus(nil),
+		rowDataChan:          make(chan *rowData, 100),
+		listWg:               &sync.WaitGroup{},
+		fetchLimiters:        &fetchCallRateLimiters{},
+		fetchMetadata:        &hydrateMetadata{},
+		parentListSemaphores: newParentListSemaphores(listConfig),
+	}
+	d.StreamListItem = d.streamListItem
+	return d
+}
+
+func TestParentListChain(t *testing.T) {
+	d := newTestParentChainQueryData(&ListConfig{
+		ParentChain: []*ParentListConfig{{Hydrate: listTestOrgs}, {Hydrate: listTestProjects}},
+		Hydrate:     listTestRepos,
+	})
+
+	// call the outermost parent list function, as doList would
+	if _, err := listTestOrgs(context.Background(), d, nil); err != nil {
+		t.Fatal(err)
+	}
+	d.listWg.Wait()
+	close(d.rowDataChan)
+
+	var rows []string
+	for rd := range d.rowDataChan {
+		parentItems := make([]string, len(rd.parentItems))
+		for i, p := range rd.parentItems {
+			parentItems[i] = p.(string)
+		}
+		if rd.parentItem != rd.parentItems[len(rd.parentItems)-1] {
+			t.Errorf("expected parent item %v to be the last of the parent items %v", rd.parentItem, rd.parentItems)
+		}
+		rows = append(rows, fmt.Sprintf("%s %s", rd.item, strings.Join(parentItems, ",")))
+	}
+	sort.Strings(rows)
+
+	expected := []string{
+		"org1/p1/r1 org1,org1/p1",
+		"org1/p2/r1 org1,org1/p2",
+		"org2/p1/r1 org2,org2/p1",
+		"org2/p2/r1 org2,org2/p2",
+	}
+	if strings.Join(rows, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected rows:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(rows, "\n"))
+	}
+}
+
+func TestParentListChainMaxConcurrency(t *testing.T) {
+	var running, maxRunning int64
+	listProjectsSlow := func(ctx context.Context, d *QueryData, h *HydrateData) (interface{}, error) {
+		n := atomic.AddInt64(&running, 1)
+		defer atomic.AddInt64(&running, -1)
+		for {
+			m := atomic.LoadInt64(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt64(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		d.StreamListItem(ctx, h.Item)
+		return nil, nil
+	}
+	listOrgs := func(ctx context.Context, d *QueryData, _ *HydrateData) (interface{}, error) {
+		d.StreamListItem(ctx, "o1", "o2", "o3", "o4", "o5")
+		return nil, nil
+	}
+
+	d := newTestParentChainQueryData(&ListConfig{
+		ParentChain: []*ParentListConfig{{Hydrate: listOrgs}, {Hydrate: listProjectsSlow, MaxConcurrency: 2}},
+		Hydrate:     listTestRepos,
+	})
+	if _, err := listOrgs(context.Background(), d, nil); err != nil {
+		t.Fatal(err)
+	}
+	d.listWg.Wait()
+
+	if len(d.rowDataChan) != 5 {
+		t.Errorf("expected 5 rows, got %d", len(d.rowDataChan))
+	}
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestParentListChainValidation(t *testing.T) {
+	table := &Table{
+		Name: "test",
+		List: &ListConfig{
+			ParentHydrate: listTestOrgs,
+			ParentChain:   []*ParentListConfig{{Hydrate: listTestOrgs}, {MaxConcurrency: -1}},
+			Hydrate:       listTestRepos,
+		},
+	}
+	table.initialise(&Plugin{Name: "test"})
+
+	errs := strings.Join(table.List.Validate(table), "\n")
+	for _, expected := range []string{
+		"table 'test' ListConfig defines both ParentHydrate and ParentChain",
+		"table 'test' ParentListConfig does not specify a hydrate function",
+		"table 'test' ParentListConfig MaxConcurrency cannot be negative",
+	} {
+		if !strings.Contains(errs, expected) {
+			t.Errorf("expected validation error '%s', got:\n%s", expected, errs)
+		}
+	}
+}
+
+func TestParentListChainCircuitBreakerConfig(t *testing.T) {
+	listBreaker := &CircuitBreakerConfig{FailureThreshold: 5}
+	parentBreaker := &CircuitBreakerConfig{FailureThreshold: 2}
+	table := &Table{
+		Name: "test",
+		List: &ListConfig{
+			ParentChain:          []*ParentListConfig{{Hydrate: listTestOrgs, CircuitBreakerConfig: parentBreaker}, {Hydrate: listTestProjects}},
+			Hydrate:              listTestRepos,
+			CircuitBreakerConfig: listBreaker,
+		},
+	}
+	table.initialise(&Plugin{Name: "test"})
+
+	parentChain := table.List.parentChain
+	if parentChain[0].CircuitBreakerConfig != parentBreaker {
+		t.Errorf("expected parent level 0 to use its own CircuitBreakerConfig, got %s", parentChain[0].CircuitBreakerConfig)
+	}
+	if parentChain[1].CircuitBreakerConfig != listBreaker {
+		t.Errorf("expected parent level 1 to default to the list CircuitBreakerConfig, got %s", parentChain[1].CircuitBreakerConfig)
+	}
+	if parentBreaker.Window != defaultCircuitBreakerWindow {
+		t.Errorf("expected parent CircuitBreakerConfig to be initialised")
+	}
+}
+
+func TestParentListChainKeyColumns(t *testing.T) {
+	listKeyColumns := OptionalColumns([]string{"name"})
+	table := &Table{
+		Name: "test",
+		List: &ListConfig{
+			ParentChain: []*ParentListConfig{{Hydrate: listTestOrgs, KeyColumns: OptionalColumns([]string{"org", "name"})}},
+			Hydrate:     listTestRepos,
+			KeyColumns:  listKeyColumns,
+		},
+	}
+	table.initialise(&Plugin{Name: "test"})
+
+	// the parent key columns are added to the list key columns used for the list call
+	if keyColumns := table.List.getKeyColumns(); len(keyColumns) != 2 || keyColumns.Find("org") == nil {
+		t.Errorf("expected list call key columns 'name' and 'org', got %s", keyColumns)
+	}
+	// the KeyColumns of the config are unchanged
+	if len(table.List.KeyColumns) != 1 || len(listKeyColumns) != 1 {
+		t.Errorf("expected ListConfig KeyColumns to be unchanged, got %s", table.List.KeyColumns)
+	}
+}
@@ -81,6 +81,15 @@ type Plugin struct {
 	DefaultConcurrency  *DefaultConcurrencyConfig
 	DefaultRetryConfig  *RetryConfig
 	DefaultIgnoreConfig *IgnoreConfig
+	// default circuit breaker config for all hydrate, get and list calls
+	// if not set, no circuit breaker is used
+	DefaultCircuitBreakerConfig *CircuitBreakerConfig
+	// default timeout for each hydrate, get and list call - if not set, calls do not time out
+	DefaultTimeout time.Duration
+	// default timeout for a query - if not set, queries do not time out
+	DefaultQueryTimeout time.Duration
+	// default matrix failure policy and concurrency for all tables
+	DefaultMatrixConfig *MatrixConfig
 
 	// rate limiter definitions - these are (optionally) defined by the plugin author
 	// and do NOT include any config overrides
@@ -96,11 +105,24 @@ type Plugin struct {
 	// ConnectionConfigChangedFunc is a callback function which is called from UpdateConnectionConfigs
 	// when any connection configs have changed
 	ConnectionConfigChangedFunc func(ctx context.Context, p *Plugin, old, new *Connection) error
+	// the TTL of items added to the connection cache using Set - defaults to 1 hour
+	ConnectionCacheTTL time.Duration
+	// ClientFactory is an optional definition of how the API clients of the plugin are created.
+	// If set, clients are retrieved using [plugin.QueryData.GetClient].
+	ClientFactory *ClientFactory
+	// ConnectionCheckFunc is an optional function which verifies a connection (e.g. that its credentials are valid).
+	// It is called by the TestConnection GRPC call.
+	ConnectionCheckFunc ConnectionCheckFunc
+	// the timeout for each ConnectionCheckFunc call - defaults to 30 seconds
+	ConnectionCheckTimeout time.Duration
 
 	// map of connection data (schema, config)
 	// keyed by connection name
 	ConnectionMap     map[string]*ConnectionData
 	connectionMapLock sync.RWMutex
+	// the errors loading the config of any connections which failed to load, keyed by connection name
+	// (protected by connectionMapLock)
+	connectionLoadErrors map[string]error
 
 	// is this a static or dynamic schema
 	SchemaMode string
@@ -114,6 +136,8 @@ type Plugin struct {
 	// map of the connection caches, keyed by connection name
 	connectionCacheMap     map[string]*connectionmanager.ConnectionCache
 	connectionCacheMapLock sync.Mutex
+	// the clients created by ClientFactory (nil if there is no ClientFactory)
+	clientRegistry *clientRegistry
 
 	// this is ConnectionKeyColumns converted to a map keyed by column name
 	// NOTE: we do not need locking as we only write to this during plugin initialisation
@@ -139,6 +163,10 @@ type Plugin struct {
 	// lock for this map
 	rateLimiterDefsMut sync.RWMutex
 
+	// map of circuit breaker INSTANCES - these are lazy loaded
+	// keyed by stringified scope values
+	circuitBreakers *circuitBreakerMap
+
 	// map of call ids to avoid duplicates
 	callIdLookup    map[string]struct{}
 	callIdLookupMut sync.RWMutex
@@ -151,6 +179,7 @@ type Plugin struct {
 // and sets the file limit.
 func (p *Plugin) initialise(logger hclog.Logger) {
 	p.ConnectionMap = make(map[string]*ConnectionData)
+	p.connectionLoadErrors = make(map[string]error)
 	p.connectionCacheMap = make(map[string]*connectionmanager.ConnectionCache)
 	p.Logger = logger
 
@@ -164,6 +193,7 @@ func (p *Plugin) initialise(logger hclog.Logger) {
 
 	log.Printf("[INFO] initialise plugin '%s', using sdk version %s", p.Name, version.String())
 	p.logMemoryLimit()
+	logFreeMemIntervalDeprecation()
 	p.initialiseRateLimits()
 
 	// default the schema mode to static
@@ -188,6 +218,22 @@ func (p *Plugin) initialise(logger hclog.Logger) {
 		p.DefaultIgnoreConfig.ShouldIgnoreError = p.DefaultShouldIgnoreError
 	}
 
+	// initialise the DefaultCircuitBreakerConfig if needed
+	if p.DefaultCircuitBreakerConfig != nil {
+		p.DefaultCircuitBreakerConfig.initialise()
+	}
+	p.circuitBreakers = newCircuitBreakerMap()
+
+	// create the client registry if needed
+	if p.ClientFactory != nil {
+		p.clientRegistry = newClientRegistry(p.ClientFactory, p.isCurrentConnection)
+	}
+
+	// initialise the DefaultMatrixConfig if needed
+	if p.DefaultMatrixConfig != nil {
+		p.DefaultMatrixConfig.initialise()
+	}
+
 	// if there is a default get config, initialise it
 	// (this ensures we handle the deprecated ShouldIgnoreError property)
 	if p.DefaultGetConfig != nil {
@@ -252,6 +298,11 @@ func (p *Plugin) shutdown() {
 		}
 	}
 
+	// close any clients created by the ClientFactory
+	if p.clientRegistry != nil {
+		p.clientRegistry.closeAll()
+	}
+
 	// destroy the temp directory
 	err := os.RemoveAll(p.tempDir)
 	if err != nil {
@@ -272,7 +323,7 @@ func (p *Plugin) ensureConnectionCache(connectionName string) (*connectionmanage
 	numConnections := len(p.ConnectionMap) + 1
 	// add to map of connection caches
 	maxCost := int64(100000 / numConnections)
-	connectionCache, err := connectionmanager.NewConnectionCache(connectionName, maxCost)
+	connectionCache, err := connectionmanager.NewConnectionCacheWithTTL(connectionName, maxCost, p.ConnectionCacheTTL)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +386,8 @@ func (p *Plugin) ConnectionSchemaChanged(connection *Connection) error {
 	connectionData.setSchema(tableMap, schema)
 
 	// if there are changes,  let the plugin manager know
-	if !oldSchema.Equals(schema) && p.messageStream != nil {
+	// NOTE: compare the connection schema, as this has any connection redaction policies applied
+	if !oldSchema.Equals(connectionData.Schema) && p.messageStream != nil {
 		return p.messageStream.Send(&proto.PluginMessage{
 			MessageType: proto.PluginMessageType_SCHEMA_UPDATED,
 			Connection:  connection.Name,
@@ -401,6 +453,18 @@ func (p *Plugin) executeForConnection(streamContext context.Context, req *proto.
 	}
 	ctx = p.buildExecuteContext(ctx, req, logger)
 
+	// apply the query timeout (if any)
+	if table.QueryTimeout > 0 {
+		var cancelQuery context.CancelFunc
+		ctx, cancelQuery = context.WithTimeout(ctx, table.QueryTimeout)
+		defer cancelQuery()
+		queryCtx := ctx
+		// if the query timed out, return a QueryTimeoutError
+		defer func() {
+			err = toQueryTimeoutError(queryCtx, err, table)
+		}()
+	}
+
 	logging.LogTime("Start execute")
 
 	queryContext := NewQueryContext(req.QueryContext, limitParam, cacheEnabled, cacheTTL, table)
@@ -424,6 +488,10 @@ func (p *Plugin) executeForConnection(streamContext context.Context, req *proto.
 	if err != nil {
 		return err
 	}
+	// when the query completes, release the clients it used
+	defer queryData.releaseClients()
+	// store the query deadline (if any) so it is available to hydrate functions
+	queryData.queryDeadline, _ = ctx.Deadline()
 
 	// set the cancel func on the query data
 	// (this is only used if the cache is enabled - if a set request has no subscribers)
@@ -481,6 +549,9 @@ func (p *Plugin) executeForConnection(streamContext context.Context, req *proto.
 		if cacheErr == nil {
 			// so we got a cached result - stream it out
 			log.Printf("[INFO] queryCacheGet returned CACHE HIT (%s)", connectionCallId)
+			if req.IncludeHydrateStats {
+				queryData.streamHydrateStats()
+			}
 
 			// nothing more to do
 			return nil
@@ -529,6 +600,11 @@ func (p *Plugin) executeForConnection(streamContext context.Context, req *proto.
 		return err
 	}
 
+	// if requested, send the hydrate stats
+	if req.IncludeHydrateStats {
+		queryData.streamHydrateStats()
+	}
+
 	return nil
 
 }
@@ -755,10 +831,29 @@ func (p *Plugin) deleteConnectionData(connections []string) {
 	p.connectionMapLock.Lock()
 	for _, deletedConnection := range connections {
 		delete(p.ConnectionMap, deletedConnection)
+		delete(p.connectionLoadErrors, deletedConnection)
 	}
 	p.connectionMapLock.Unlock()
 }
 
+// safely store the error loading the config of a connection (or clear it if err is nil)
+func (p *Plugin) setConnectionLoadError(connectionName string, err error) {
+	p.connectionMapLock.Lock()
+	defer p.connectionMapLock.Unlock()
+	if err == nil {
+		delete(p.connectionLoadErrors, connectionName)
+		return
+	}
+	p.connectionLoadErrors[connectionName] = err
+}
+
+// safely get the error (if any) loading the config of a connection
+func (p *Plugin) getConnectionLoadError(connectionName string) error {
+	p.connectionMapLock.RLock()
+	defer p.connectionMapLock.RUnlock()
+	return p.connectionLoadErrors[connectionName]
+}
+
 // TODO this is duplicated from pipe-fittings - only exists here until AWS plugin is updated to latest sdk so we can reference pipe-fittings
 
 // SqlLike simulates SQL LIKE pattern matching using fnmatch, with an option for case sensitivity.
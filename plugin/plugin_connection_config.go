@@ -67,6 +67,17 @@ func (p *Plugin) updateConnections(ctx context.Context, changed []*proto.Connect
 			// in which case there will be an error in updateData
 			continue
 		}
+		// reset any state which depends on the previous config (whatever ConnectionConfigChangedFunc does):
+		// - the config change may have resolved the cause of any failures, so reset the circuit breakers
+		p.circuitBreakers.clearForConnection(c)
+		// - the clients were created using the previous config, so close them
+		p.closeClients(c)
+		// - the cached rows were redacted using the previous redaction policies, so if these have changed, clear the query cache
+		if !existingConnections[c].redaction.equals(connectionData.Connection.redaction) {
+			if err := p.ClearQueryCache(ctx, c); err != nil {
+				log.Printf("[WARN] failed to clear query cache for connection %s, error: %s", c, err.Error())
+			}
+		}
 		p.ConnectionConfigChangedFunc(ctx, p, existingConnections[c], connectionData.Connection)
 	}
 	return
@@ -89,8 +100,10 @@ func (p *Plugin) deleteConnections(deleted []*proto.ConnectionConfig) {
 		deletedNames := make([]string, len(deleted))
 		for i, c := range deleted {
 			deletedNames[i] = c.Connection
+			p.circuitBreakers.clearForConnection(c.Connection)
 		}
 		p.deleteConnectionData(deletedNames)
+		p.closeClients(deletedNames...)
 	}
 }
 
@@ -112,6 +125,8 @@ func (p *Plugin) upsertConnections(configs []*proto.ConnectionConfig, updateData
 		} else {
 			p.upsertConnectionData(config, updateData)
 		}
+		// store the load error (if any) so it can be reported by the connection check
+		p.setConnectionLoadError(config.Connection, updateData.failedConnections[config.Connection])
 	}
 }
 
@@ -130,7 +145,7 @@ func (p *Plugin) upsertConnectionData(config *proto.ConnectionConfig, updateData
 
 	// if config was provided, parse it
 	// (this returns nil if there was no config - that is ok
-	configStruct, err := p.parseConnectionConfig(config)
+	configStruct, sdkConfig, err := p.parseConnectionConfig(config)
 	if err != nil {
 		updateData.failedConnections[connectionName] = err
 		return
@@ -151,6 +166,9 @@ func (p *Plugin) upsertConnectionData(config *proto.ConnectionConfig, updateData
 
 	// set config struct (may be nil)
 	d.Connection.Config = configStruct
+	// set the error rules and redaction policies (may be nil)
+	d.Connection.errorRules = sdkConfig.errorRules
+	d.Connection.redaction = sdkConfig.redaction
 
 	// set the schema
 
@@ -198,25 +216,35 @@ func (p *Plugin) getExemplarSchemaFromUpdateData(updateData *connectionUpdateDat
 	return schema, tableMap, nil
 }
 
-func (p *Plugin) parseConnectionConfig(config *proto.ConnectionConfig) (any, error) {
+func (p *Plugin) parseConnectionConfig(config *proto.ConnectionConfig) (any, *sdkConnectionConfig, error) {
+	// first parse any config attributes which are handled by the SDK (error rules and redaction policies)
+	sdkConfig, hasPluginConfig, err := parseSdkConnectionConfig(config, p.ConnectionConfigSchema)
+	if err != nil {
+		log.Printf("[WARN] upsertConnectionData failed for connection %s, SDK connection config validation failed: %s", config.Connection, err.Error())
+		return nil, nil, err
+	}
 	if config.Config == "" {
-		return nil, nil
+		return nil, sdkConfig, nil
 	}
 
 	if p.ConnectionConfigSchema == nil {
+		// if the config only contains SDK config, that is ok
+		if !hasPluginConfig {
+			return nil, sdkConfig, nil
+		}
 		msg := fmt.Sprintf("connection config has been set for connection '%s', but plugin '%s' does not define connection config schema", config.Connection, p.Name)
 		log.Println("[WARN]", msg)
-		return nil, sperr.New(msg)
+		return nil, nil, sperr.New(msg)
 
 	}
 	// parse the config into a struct
 	configStruct, err := p.ConnectionConfigSchema.parse(config)
 	if err != nil {
 		log.Printf("[WARN] upsertConnectionData failed for connection %s, config validation failed: %s", config.Connection, err.Error())
-		return nil, err
+		return nil, nil, err
 	}
 
-	return configStruct, err
+	return configStruct, sdkConfig, err
 }
 
 func (p *Plugin) getConnectionSchema(c *Connection) (map[string]*Table, *grpc.PluginSchema, error) {
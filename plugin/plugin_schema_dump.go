@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/logging"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v5/version"
+)
+
+// the name of the connection used by GetPluginSchema and GetTableDocs
+const standaloneConnectionName = "standalone"
+
+/*
+GetPluginSchema creates the plugin using pluginFunc, sets the given connection config
+and returns the full plugin schema, as returned by the GetSchema GRPC call.
+
+connectionConfig is the HCL connection config - this may be empty, unless the plugin needs its config to build the schema
+(for example a plugin with a dynamic schema).
+
+This allows a plugin to compare its schema with a previously dumped version in a test,
+using [schema_diff.Diff]:
+
+	func TestSchemaCompatibility(t *testing.T) {
+		previous, err := schema_diff.ReadSchema("testdata/schema.json")
+		...
+		current, err := plugin.GetPluginSchema(context.Background(), aws.Plugin, "")
+		...
+		if err := schema_diff.Diff(previous, current).Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+*/
+func GetPluginSchema(ctx context.Context, pluginFunc PluginFunc, connectionConfig string) (*proto.Schema, error) {
+	p, err := newStandalonePlugin(ctx, pluginFunc, connectionConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer p.shutdown()
+
+	schema, err := p.getSchema(standaloneConnectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Schema{
+		Schema:          schema.Schema,
+		Mode:            schema.Mode,
+		SdkVersion:      version.String(),
+		ProtocolVersion: version.ProtocolVersion,
+	}, nil
+}
+
+// newStandalonePlugin creates and initialises the plugin outside of the plugin server,
+// and adds a single connection with the given config
+// NOTE: the caller must call shutdown on the returned plugin
+func newStandalonePlugin(ctx context.Context, pluginFunc PluginFunc, connectionConfig string) (*Plugin, error) {
+	logger, ok := ctx.Value(context_key.Logger).(hclog.Logger)
+	if !ok {
+		logger = logging.NewLogger(&hclog.LoggerOptions{Output: io.Discard})
+		ctx = context.WithValue(ctx, context_key.Logger, logger)
+	}
+
+	p := pluginFunc(ctx)
+	p.initialise(logger)
+
+	config := &proto.ConnectionConfig{
+		Connection: standaloneConnectionName,
+		Plugin:     p.Name,
+		Config:     connectionConfig,
+	}
+	// pass -1 as the max cache size so no query cache is created
+	failedConnections, err := p.setAllConnectionConfigs([]*proto.ConnectionConfig{config}, -1)
+	// if the connection config failed to parse, return the parse error in preference to any error it caused
+	if connectionErr := failedConnections[standaloneConnectionName]; connectionErr != nil {
+		err = connectionErr
+	}
+	if err != nil {
+		p.shutdown()
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,84 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/schema"
+	"github.com/turbot/steampipe-plugin-sdk/v5/schema_diff"
+	"github.com/turbot/steampipe-plugin-sdk/v5/version"
+)
+
+func schemaDumpTestPlugin(columns ...*Column) PluginFunc {
+	return func(context.Context) *Plugin {
+		return &Plugin{
+			Name: "schema_dump_test",
+			TableMap: map[string]*Table{
+				"thing": {
+					Name: "thing",
+					List: &ListConfig{
+						Hydrate:    listHydrate,
+						KeyColumns: SingleColumn("id"),
+					},
+					Columns: columns,
+				},
+			},
+		}
+	}
+}
+
+func TestGetPluginSchema(t *testing.T) {
+	ctx := context.Background()
+	schema, err := GetPluginSchema(ctx, schemaDumpTestPlugin(
+		&Column{Name: "id", Type: proto.ColumnType_STRING},
+		&Column{Name: "name", Type: proto.ColumnType_STRING},
+	), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.SdkVersion != version.String() || schema.Mode != SchemaModeStatic {
+		t.Errorf("unexpected schema properties: sdk version '%s', mode '%s'", schema.SdkVersion, schema.Mode)
+	}
+	table, ok := schema.Schema["thing"]
+	if !ok {
+		t.Fatal("expected table 'thing' in schema")
+	}
+	// the schema includes the table columns (as well as the standard columns) and key columns
+	if len(table.Columns) < 2 || table.Columns[0].Name != "id" || table.Columns[1].Name != "name" {
+		t.Errorf("unexpected table columns: %v", table.Columns)
+	}
+	if len(table.ListCallKeyColumnList) == 0 || table.ListCallKeyColumnList[0].Name != "id" || table.ListCallKeyColumnList[0].Require != Required {
+		t.Errorf("unexpected list key columns: %v", table.ListCallKeyColumnList)
+	}
+
+	// changing the type of a column is reported as a breaking change
+	changed, err := GetPluginSchema(ctx, schemaDumpTestPlugin(
+		&Column{Name: "id", Type: proto.ColumnType_INT},
+		&Column{Name: "name", Type: proto.ColumnType_STRING},
+	), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema_diff.Diff(schema, changed).Err(); err == nil {
+		t.Error("expected a breaking change")
+	}
+	if err := schema_diff.Diff(schema, schema).Err(); err != nil {
+		t.Errorf("expected no breaking changes, got %s", err.Error())
+	}
+}
+
+func TestGetPluginSchemaInvalidConnectionConfig(t *testing.T) {
+	pluginFunc := func(ctx context.Context) *Plugin {
+		p := schemaDumpTestPlugin(&Column{Name: "id", Type: proto.ColumnType_STRING})(ctx)
+		p.ConnectionConfigSchema = &ConnectionConfigSchema{
+			NewInstance: func() interface{} { return &stringPropertyCty{} },
+			Schema:      map[string]*schema.Attribute{"region": {Type: schema.TypeString}},
+		}
+		return p
+	}
+	// the connection config parse error is returned
+	if _, err := GetPluginSchema(context.Background(), pluginFunc, `region = `); err == nil {
+		t.Error("expected an error for an invalid connection config")
+	}
+}
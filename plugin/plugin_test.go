@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 type validateTest struct {
@@ -322,6 +323,61 @@ var testCasesValidate = map[string]validateTest{
 		},
 		expected: []string{"table 'table' GetConfig does not specify a KeyColumn"},
 	},
+	"invalid transform expression": {
+		plugin: Plugin{
+			Name: "plugin",
+			TableMap: map[string]*Table{
+				"table": {
+					Name: "table",
+					Columns: []*Column{
+						{
+							Name: "name",
+							Type: proto.ColumnType_STRING,
+						},
+						{
+							Name:      "c1",
+							Type:      proto.ColumnType_STRING,
+							Transform: transform.FromJMESPath("tags[?"),
+						},
+					},
+					List: &ListConfig{
+						Hydrate: listHydrate,
+					},
+				},
+			},
+			RequiredColumns: []*Column{{Name: "name", Type: proto.ColumnType_STRING}},
+		},
+		expected: []string{"table 'table' column 'c1' has an invalid transform: failed to compile jmespath expression 'tags[?': SyntaxError: Incomplete expression"},
+	},
+	"deprecated table replaced by missing table": {
+		plugin: Plugin{
+			Name: "plugin",
+			TableMap: map[string]*Table{
+				"table": {
+					Name:       "table",
+					Deprecated: true,
+					ReplacedBy: "table_v2",
+					Columns: []*Column{
+						{
+							Name: "name",
+							Type: proto.ColumnType_STRING,
+						},
+						{
+							Name:       "c1",
+							Type:       proto.ColumnType_STRING,
+							Deprecated: true,
+							ReplacedBy: "name",
+						},
+					},
+					List: &ListConfig{
+						Hydrate: listHydrate,
+					},
+				},
+			},
+			RequiredColumns: []*Column{{Name: "name", Type: proto.ColumnType_STRING}},
+		},
+		expected: []string{"table 'table' is replaced by table 'table_v2', which does not exist"},
+	},
 	"no get hydrate": {
 		plugin: Plugin{
 			Name: "plugin",
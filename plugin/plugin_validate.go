@@ -13,10 +13,16 @@ func (p *Plugin) validate(tableMap map[string]*Table) (validationWarnings, valid
 		w, e := table.validate(tableName, p.RequiredColumns)
 		validationWarnings = append(validationWarnings, w...)
 		validationErrors = append(validationErrors, e...)
+		if _, ok := tableMap[table.ReplacedBy]; table.ReplacedBy != "" && !ok {
+			validationErrors = append(validationErrors, fmt.Sprintf("table '%s' is replaced by table '%s', which does not exist", table.Name, table.ReplacedBy))
+		}
 	}
 	if p.ConnectionConfigSchema != nil {
 		validationErrors = append(validationErrors, p.ConnectionConfigSchema.Validate()...)
 	}
+	if p.ClientFactory != nil {
+		validationErrors = append(validationErrors, p.ClientFactory.validate()...)
+	}
 
 	// validate the schema mode
 	if err := ValidateSchemaMode(p.SchemaMode); err != nil {
@@ -29,6 +35,20 @@ func (p *Plugin) validate(tableMap map[string]*Table) (validationWarnings, valid
 	log.Printf("[TRACE] validate DefaultIgnoreConfig")
 	validationErrors = append(validationErrors, p.DefaultIgnoreConfig.validate(nil)...)
 
+	validationErrors = append(validationErrors, validateTimeout(p.DefaultTimeout, "DefaultTimeout", nil)...)
+	validationErrors = append(validationErrors, validateTimeout(p.DefaultQueryTimeout, "DefaultQueryTimeout", nil)...)
+	validationErrors = append(validationErrors, validateTimeout(p.ConnectionCheckTimeout, "ConnectionCheckTimeout", nil)...)
+
+	if p.DefaultCircuitBreakerConfig != nil {
+		log.Printf("[TRACE] validate DefaultCircuitBreakerConfig")
+		validationErrors = append(validationErrors, p.DefaultCircuitBreakerConfig.validate(nil)...)
+	}
+
+	if p.DefaultMatrixConfig != nil {
+		log.Printf("[TRACE] validate DefaultMatrixConfig")
+		validationErrors = append(validationErrors, p.DefaultMatrixConfig.validate(nil)...)
+	}
+
 	log.Printf("[TRACE] validate table names")
 	validationErrors = append(validationErrors, p.validateTableNames()...)
 
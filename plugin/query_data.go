@@ -6,8 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"runtime/debug"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/turbot/go-kit/helpers"
@@ -16,14 +17,11 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/error_helpers"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
-	"github.com/turbot/steampipe-plugin-sdk/v5/logging"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
 	"github.com/turbot/steampipe-plugin-sdk/v5/query_cache"
-	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
 	"github.com/turbot/steampipe-plugin-sdk/v5/sperr"
 	"github.com/turbot/steampipe-plugin-sdk/v5/telemetry"
 	"golang.org/x/exp/maps"
-	"golang.org/x/sync/semaphore"
 )
 
 // how may rows do we cache in the rowdata channel
@@ -102,6 +100,16 @@ type QueryData struct {
 	hydrateCalls []*hydrateCall
 	// the rate limiter(s) which apply to the fetch call
 	fetchLimiters *fetchCallRateLimiters
+	// the circuit breaker (if any) which applies to the fetch call
+	// (for a parent-child list, this is the breaker for the outermost parent list call)
+	fetchCircuitBreaker *circuitBreaker
+	// the circuit breakers (if any) which apply to each level of a parent list chain, and to the child list call
+	parentListCircuitBreakers []*circuitBreaker
+	childListCircuitBreaker   *circuitBreaker
+	// the query deadline (zero if the query has no deadline)
+	queryDeadline time.Time
+	// the clients (created by the plugin ClientFactory) in use by the query
+	clients *queryClients
 
 	// all the columns that will be returned by this query
 	columns     map[string]*QueryColumn
@@ -113,6 +121,13 @@ type QueryData struct {
 	listWg *sync.WaitGroup
 	// when executing parent child list calls, we cache the parent list result in the query data passed to the child list call
 	parentItem interface{}
+	// the parent list results for each level of a parent list chain
+	parentItems []interface{}
+	// the level of the parent list chain whose items are streamed using this query data
+	// (if this is equal to the length of the chain, the items are leaf items)
+	listLevel int
+	// limit the concurrent calls of each level of a parent list chain
+	parentListSemaphores parentListSemaphores
 
 	filteredMatrix []map[string]interface{}
 	// column quals which were used to filter the matrix
@@ -136,9 +151,6 @@ type QueryData struct {
 	// map of hydrate function name to columns it provides
 	// (this is in queryData not Table as it gets modified per query)
 	hydrateColumnMap map[string][]string
-	// tactical - free memory after streaming this many rows
-	freeMemInterval int64
-
 	// temp dir for the connection
 	tempDir         string
 	reservedColumns map[string]struct{}
@@ -187,7 +199,8 @@ func newQueryData(connectionCallId string, p *Plugin, queryContext *QueryContext
 		outputChan:  outputChan,
 		listWg:      &wg,
 
-		freeMemInterval: GetFreeMemInterval(),
+		parentListSemaphores: newParentListSemaphores(table.List),
+
 		reservedColumns: getReservedColumns(table),
 
 		// temporary dir for this connection
@@ -196,6 +209,10 @@ func newQueryData(connectionCallId string, p *Plugin, queryContext *QueryContext
 		matrixColLookup: make(map[string]struct{}),
 	}
 
+	if p.clientRegistry != nil {
+		d.clients = newQueryClients(p.clientRegistry)
+	}
+
 	d.StreamListItem = d.streamListItem
 	// for legacy compatibility - plugins should no longer call StreamLeafListItem directly
 	d.StreamLeafListItem = d.streamLeafListItem
@@ -214,6 +231,13 @@ func newQueryData(connectionCallId string, p *Plugin, queryContext *QueryContext
 	// populate the query status
 	// if a limit is set, use this to set rows required - otherwise just set to MaxInt32
 	d.queryStatus = newQueryStatus(d.QueryContext.Limit)
+	// NOTE: if there is an explode hydrate call, the number of rows produced by each item is not known,
+	// so the limit is applied to the exploded rows rather than the items fetched
+	if d.getExplodeCall() != nil {
+		d.queryStatus.applyLimitToExplodedRows()
+	}
+	// warn if the table or any requested columns are deprecated
+	d.addDeprecationWarnings()
 
 	return d, nil
 }
@@ -247,13 +271,19 @@ func (d *QueryData) shallowCopy() *QueryData {
 		cacheTtl:          d.cacheTtl,
 		cacheEnabled:      d.cacheEnabled,
 
-		fetchLimiters:  d.fetchLimiters,
-		filteredMatrix: d.filteredMatrix,
+		fetchLimiters:             d.fetchLimiters,
+		fetchCircuitBreaker:       d.fetchCircuitBreaker,
+		parentListCircuitBreakers: d.parentListCircuitBreakers,
+		childListCircuitBreaker:   d.childListCircuitBreaker,
+		queryDeadline:             d.queryDeadline,
+		clients:                   d.clients,
+		filteredMatrix:            d.filteredMatrix,
 
 		rowDataChan:            d.rowDataChan,
 		errorChan:              d.errorChan,
 		outputChan:             d.outputChan,
 		listWg:                 d.listWg,
+		parentListSemaphores:   d.parentListSemaphores,
 		columns:                d.columns,
 		queryStatus:            d.queryStatus,
 		matrixColLookup:        d.matrixColLookup,
@@ -296,11 +326,7 @@ func (d *QueryData) shallowCopy() *QueryData {
 //   - if there is a limit, it will return the number of rows required to reach this limit
 //   - if  the context has been cancelled, it will return zero
 func (d *QueryData) RowsRemaining(ctx context.Context) int64 {
-	if IsCancelled(ctx) {
-		return 0
-	}
-	rowsRemaining := d.queryStatus.rowsRequired - d.queryStatus.rowsStreamed
-	return rowsRemaining
+	return d.queryStatus.RowsRemaining(ctx)
 }
 
 // EqualsQualString looks for the specified key column quals and if it exists, return the value as a string
@@ -336,37 +362,35 @@ func (d *QueryData) filterMatrixItems() {
 	var filteredMatrix []map[string]interface{}
 
 	// build a keycolumn slice from the matrix items
+	// (include all operators which can be evaluated against the matrix values)
 	var matrixKeyColumns KeyColumnSlice
 	for column := range d.Matrix[0] {
 		matrixKeyColumns = append(matrixKeyColumns, &KeyColumn{
 			Name:      column,
-			Operators: []string{"="},
+			Operators: matrixFilterOperators,
 		})
 	}
 	// now see which of these key columns are satisfied by the provided quals
 	matrixQualMap := NewKeyColumnQualValueMap(d.QueryContext.UnsafeQuals, matrixKeyColumns)
 
+	// the columns whose quals excluded at least one matrix item
+	filteredColumns := make(map[string]struct{})
 	for _, m := range d.Matrix {
 		log.Printf("[TRACE] matrix item %v", m)
-		// do all key columns which exist for this matrix item match the matrix values?
+		// do all the values of this matrix item satisfy the quals for the matrix columns?
 		includeMatrixItem := true
 
 		for col, val := range m {
-			log.Printf("[TRACE] col %s val %s", col, val)
 			// is there a quals for this matrix column?
-
-			if matrixQuals, ok := matrixQualMap[col]; ok {
-				log.Printf("[TRACE] quals found for matrix column: %v", matrixQuals)
-				// if there IS a single equals qual which DOES NOT match this matrix item, exclude the matrix item
-				if matrixQuals.SingleEqualsQual() {
-					includeMatrixItem = d.shouldIncludeMatrixItem(matrixQuals, val)
-					// store this column - we will need this when building a cache key
-					if !includeMatrixItem {
-						d.filteredMatrixColumns = append(d.filteredMatrixColumns, col)
-					}
-				}
-			} else {
-				log.Printf("[TRACE] quals found for matrix column: %s", col)
+			matrixQuals, ok := matrixQualMap[col]
+			if !ok {
+				continue
+			}
+			log.Printf("[TRACE] quals found for matrix column %s: %v", col, matrixQuals)
+			if !matrixValueSatisfiesQuals(matrixQuals.Quals, val) {
+				includeMatrixItem = false
+				// store this column - we will need this when building a cache key
+				filteredColumns[col] = struct{}{}
 			}
 		}
 
@@ -378,7 +402,9 @@ func (d *QueryData) filterMatrixItems() {
 		}
 	}
 	d.filteredMatrix = filteredMatrix
-	log.Printf("[TRACE] filtered matrix: %v", d.Matrix)
+	d.filteredMatrixColumns = maps.Keys(filteredColumns)
+	sort.Strings(d.filteredMatrixColumns)
+	log.Printf("[TRACE] filtered matrix: %v", d.filteredMatrix)
 }
 
 func (d *QueryData) populateMatrixPropertyNames() {
@@ -461,7 +487,11 @@ func (d *QueryData) addColumnsForHydrate(hydrateName string) {
 	for _, columnName := range d.hydrateColumnMap[hydrateName] {
 		// get the column from the table
 		column := d.Table.getColumn(columnName)
-		d.columns[columnName] = NewQueryColumn(column, hydrateName)
+		queryColumn := NewQueryColumn(column, hydrateName)
+		// resolve the column transforms and redaction policy now, rather than for every row
+		queryColumn.transforms = d.Table.getColumnTransforms(queryColumn)
+		queryColumn.redaction = getConnectionRedaction(d).policyForColumn(d.Table.Name, column)
+		d.columns[columnName] = queryColumn
 	}
 }
 
@@ -502,9 +532,9 @@ func (d *QueryData) setFetchType(table *Table) {
 		log.Printf("[TRACE] Set fetchType to fetchTypeList")
 		// if there is a list config default to list, even is we are missing required quals
 		d.FetchType = fetchTypeList
-		if len(table.List.KeyColumns) > 0 {
+		if len(table.List.getKeyColumns()) > 0 {
 			// build a qual map from List key columns
-			qualMap := NewKeyColumnQualValueMap(d.QueryContext.UnsafeQuals, table.List.KeyColumns)
+			qualMap := NewKeyColumnQualValueMap(d.QueryContext.UnsafeQuals, table.List.getKeyColumns())
 			d.setQuals(qualMap)
 		}
 	}
@@ -518,25 +548,6 @@ func (d *QueryData) setQuals(qualMap KeyColumnQualMap) {
 	d.logQualMaps()
 }
 
-func (d *QueryData) shouldIncludeMatrixItem(quals *KeyColumnQuals, matrixVal interface{}) bool {
-	log.Printf("[TRACE] there is a single equals qual")
-
-	// if the value is an array, this is an IN query - check whether the array contains the matrix value
-	if listValue := quals.Quals[0].Value.GetListValue(); listValue != nil {
-		log.Printf("[TRACE] the qual value is a list: %v", listValue)
-		for _, qv := range listValue.Values {
-			if grpc.GetQualValue(qv) == matrixVal {
-				log.Printf("[TRACE] qual value list contains matrix value %v", matrixVal)
-				return true
-			}
-		}
-		return false
-	}
-
-	// otherwise it is a single qual value
-	return grpc.GetQualValue(quals.Quals[0].Value) == matrixVal
-}
-
 func (d *QueryData) logQualMaps() {
 	log.Printf("[TRACE] Equals key column quals:\n%s", d.EqualsQuals)
 	log.Printf("[TRACE] All key column quals:\n%s", d.Quals)
@@ -547,8 +558,7 @@ func (d *QueryData) verifyCallerIsListCall(callingFunction string) bool {
 		return false
 	}
 	listFunction := d.Table.List.namedHydrate.Name
-	listParentFunction := d.Table.List.namedParentHydrate.Name
-	if callingFunction != listFunction && callingFunction != listParentFunction {
+	if callingFunction != listFunction && d.Table.List.getParentLevel(callingFunction) == -1 {
 		// if the calling function is NOT one of the other registered hydrate functions,
 		//it must be an anonymous function so let it go
 		for _, c := range d.Table.Columns {
@@ -565,11 +575,11 @@ func (d *QueryData) streamListItem(ctx context.Context, items ...interface{}) {
 	// loop over items
 	for _, item := range items {
 		// have we streamed enough already?
-		if d.queryStatus.StreamingComplete {
+		if d.queryStatus.StreamingComplete.Load() {
 			return
 		}
-		// if this table has no parent hydrate function, just call streamLeafListItem directly
-		if d.Table.List.ParentHydrate != nil {
+		// if this table has no parent hydrate function (or these are leaf items), just call streamLeafListItem directly
+		if d.listLevel < len(d.Table.List.parentChain) {
 			// so there is a parent-child hydrate - call the child hydrate, passing 'item' as the parent item
 			d.callChildListHydrate(ctx, item)
 		} else {
@@ -579,18 +589,35 @@ func (d *QueryData) streamListItem(ctx context.Context, items ...interface{}) {
 	}
 }
 
-// there is a parent-child list hydration - call the child list function passing 'item' as the parent item'
+// there is a parent-child list hydration - call the next list function in the parent chain, passing 'item' as the parent item
+// (if the items are from the innermost parent list function, this is the child list function)
 func (d *QueryData) callChildListHydrate(ctx context.Context, parentItem interface{}) {
 	// do a deep nil check on item - if nil, just return to skip this item
 	if helpers.IsNil(parentItem) {
 		return
 	}
 
-	// wait for any configured child ListCall rate limiters
-	rateLimitDelay := d.fetchLimiters.childListWait(ctx)
-
-	// populate delay in metadata
-	d.fetchMetadata.DelayMs = rateLimitDelay.Milliseconds()
+	parentChain := d.Table.List.parentChain
+	level := d.listLevel + 1
+	isChildList := level == len(parentChain)
+
+	var rateLimitDelay time.Duration
+	var listCall namedHydrateFunc
+	var fetchMetadata *hydrateMetadata
+	if isChildList {
+		listCall = d.Table.List.namedHydrate
+		// wait for any configured child ListCall rate limiters
+		rateLimitDelay = d.fetchLimiters.childListWait(ctx)
+		// populate delay in metadata
+		// NOTE: child list calls run concurrently, so each child has its own copy of the metadata
+		fetchMetadata = d.fetchMetadata.withDelay(rateLimitDelay)
+		d.getHydrateStats(listCall.Name).onRateLimit(rateLimitDelay, fetchMetadata.RateLimiters)
+	} else {
+		listCall = parentChain[level].namedHydrate
+		// wait for any configured rate limiters for this level of the parent chain
+		rateLimitDelay = d.fetchLimiters.parentListWait(ctx, level)
+		d.getHydrateStats(listCall.Name).onRateLimit(rateLimitDelay, d.fetchLimiters.parentListLimiterNames(level))
+	}
 
 	callingFunction := helpers.GetCallingFunction(1)
 	d.listWg.Add(1)
@@ -606,14 +633,47 @@ func (d *QueryData) callChildListHydrate(ctx context.Context, parentItem interfa
 			}
 		}()
 		defer d.listWg.Done()
-		// create a copy of query data with the stream function set to streamLeafListItem
+		// create a copy of query data for the next level
 		childQueryData := d.shallowCopy()
-		childQueryData.StreamListItem = childQueryData.streamLeafListItem
+		childQueryData.matrixItem = d.matrixItem
+		childQueryData.listLevel = level
 		// set parent list result so that it can be stored in rowdata hydrate results in streamLeafListItem
 		childQueryData.parentItem = parentItem
-		// now call the child list
-		_, err := d.Table.List.Hydrate(ctx, childQueryData, &HydrateData{Item: parentItem})
+		childQueryData.parentItems = append(append([]interface{}{}, d.parentItems...), parentItem)
+
+		if isChildList {
+			childQueryData.fetchMetadata = fetchMetadata
+			// set the stream function to streamLeafListItem
+			childQueryData.StreamListItem = childQueryData.streamLeafListItem
+			// now call the child list
+			start := time.Now()
+			childHydrate := d.childListCircuitBreaker.wrap(withTimeout(listCall, d.Table.List.Timeout), d.Table.List.IgnoreConfig)
+			_, err := childHydrate.Func(ctx, childQueryData, &HydrateData{Item: parentItem, ParentItems: childQueryData.parentItems})
+			d.getHydrateStats(listCall.Name).onCall(string(fetchTypeList), time.Since(start))
+			if err != nil {
+				d.streamError(err)
+			}
+			return
+		}
+
+		// otherwise call the next parent list function
+		parent := parentChain[level]
+		release, err := d.parentListSemaphores.acquire(ctx, level)
+		if err != nil {
+			d.streamError(err)
+			return
+		}
+		defer release()
+
+		// create rowData, purely so we can call callHydrateWithRetries
+		rd := newRowData(childQueryData, parentItem)
+		rd.parentItems = childQueryData.parentItems
+		// we cannot retry errors in the list hydrate function after streaming has started
+		listRetryConfig := parent.RetryConfig.GetListRetryConfig()
+		parentHydrate := d.parentListCircuitBreaker(level).wrap(withTimeout(listCall, parent.Timeout), parent.IgnoreConfig)
+		_, err = rd.callHydrateWithRetries(ctx, childQueryData, parentHydrate, parent.IgnoreConfig, listRetryConfig)
 		if err != nil {
+			log.Printf("[WARN] parent list call %s returned error %v", listCall.Name, err)
 			d.streamError(err)
 		}
 	}()
@@ -623,35 +683,30 @@ func (d *QueryData) streamLeafListItem(ctx context.Context, items ...interface{}
 	// loop over items
 	for _, item := range items {
 		// have we streamed enough already?
-		if d.queryStatus.StreamingComplete {
+		if d.queryStatus.StreamingComplete.Load() {
 			return
 		}
 
 		// if this is the first time we have received a zero rows remaining, stream an empty row and mark stream
 		if d.queryStatus.RowsRemaining(ctx) == 0 {
+			// leaf items may be streamed concurrently - only the first caller to mark the stream complete sends the empty row
+			if !d.queryStatus.StreamingComplete.CompareAndSwap(false, true) {
+				return
+			}
 			log.Printf("[TRACE] streamListItem RowsRemaining zero, send nil row %s", d.Connection.Name)
-			d.queryStatus.StreamingComplete = true
 			// if this is the first time we have received a zero rows remaining, stream an empty row
 			// to indicate downstream that we are done
 			d.rowDataChan <- nil
-			// we are done - give memory back to OS at once
-			debug.FreeOSMemory()
 			return
 		}
 
-		// tactical - if we have specified freeMemInterval, check whether we have reached it and need to free memory
-		// this is to reduce memory pressure dure to streaming high row counts
-		if d.shouldFreeMemory() {
-			debug.FreeOSMemory()
-		}
-
 		// do a deep nil check on item - if nil, just skip this item
 		if helpers.IsNil(item) {
 			log.Printf("[TRACE] streamLeafListItem received nil item, skipping")
 			continue
 		}
 		// increment the stream count
-		d.queryStatus.rowsStreamed++
+		d.queryStatus.rowsStreamed.Add(1)
 
 		// create rowData, passing matrixItem from context
 		rd := newRowData(d, item)
@@ -659,6 +714,7 @@ func (d *QueryData) streamLeafListItem(ctx context.Context, items ...interface{}
 		rd.matrixItem = d.matrixItem
 		// set the parent item on the row data
 		rd.parentItem = d.parentItem
+		rd.parentItems = d.parentItems
 		// NOTE: add the item as the hydrate data for the list call
 		// we do not expect this to fail as we just created the rowdata
 		// (it only fails for duplicate hydrate func values)
@@ -668,11 +724,6 @@ func (d *QueryData) streamLeafListItem(ctx context.Context, items ...interface{}
 	}
 }
 
-// if a free memory interval has been set, check if we have reached it
-func (d *QueryData) shouldFreeMemory() bool {
-	return d.freeMemInterval != 0 && d.queryStatus.rowsStreamed%d.freeMemInterval == 0
-}
-
 // called when all items have been fetched - close the item chan
 func (d *QueryData) fetchComplete(ctx context.Context) {
 	log.Printf("[TRACE] QueryData.fetchComplete")
@@ -683,59 +734,9 @@ func (d *QueryData) fetchComplete(ctx context.Context) {
 }
 
 // iterate over rowDataChan, for each item build the row and stream over rowChan
+// rows are built by a bounded pool of workers (see rowBuilder)
 func (d *QueryData) buildRowsAsync(ctx context.Context, rowChan chan *proto.Row, doneChan chan bool) {
-	// we need to use a wait group for rows we cannot close the row channel when the item channel is closed
-	// as getRow is executing asyncronously
-	var rowWg sync.WaitGroup
-	maxConcurrentRows := rate_limiter.GetMaxConcurrentRows()
-	var rowSemaphore *semaphore.Weighted
-	if maxConcurrentRows > 0 {
-		rowSemaphore = semaphore.NewWeighted(int64(maxConcurrentRows))
-	}
-
-	// start goroutine to read items from item chan and generate row data
-	go func() {
-		// wait group used to ensure row ordering
-		var prevRowOrderingWg *sync.WaitGroup
-		for {
-			// wait for either an rowData or an error
-			select {
-			case <-doneChan:
-				log.Printf("[INFO] buildRowsAsync done channel selected - quitting %s", d.Connection.Name)
-				return
-			case rowData := <-d.rowDataChan:
-				logging.LogTime("got rowData - calling getRow")
-				// is there any more data?
-				if rowData == nil {
-					log.Printf("[TRACE] rowData chan returned nil - wait for rows to complete (%s)", d.connectionCallId)
-					// now we know there will be no more items, close row chan when the wait group is complete
-					// this allows time for all hydrate goroutines to complete
-					d.waitForRowsToComplete(&rowWg, rowChan)
-					// rowData channel closed - nothing more to do
-					return
-				}
-				if rowSemaphore != nil {
-					t := time.Now()
-					//log.Printf("[INFO] buildRowsAsync acquire semaphore (%s)", d.connectionCallId)
-					if err := rowSemaphore.Acquire(ctx, 1); err != nil {
-						log.Printf("[INFO] SEMAPHORE ERROR %s", err)
-						d.errorChan <- err
-						return
-					}
-					if time.Since(t) > 1*time.Millisecond {
-						log.Printf("[INFO] buildRowsAsync waited %dms to hydrate row (%s)", time.Since(t).Milliseconds(), d.connectionCallId)
-					}
-				}
-				rowWg.Add(1)
-
-				// increment ordering wg
-				rowData.orderingWg.Add(1)
-				d.buildRowAsync(ctx, rowData, rowChan, &rowWg, rowSemaphore, prevRowOrderingWg)
-				// update the wait group
-				prevRowOrderingWg = &rowData.orderingWg
-			}
-		}
-	}()
+	go newRowBuilder(d, rowChan, doneChan).run(ctx)
 }
 
 // read rows from rowChan and stream either intop the cache (if enabled) or back across GRPC if not
@@ -826,65 +827,50 @@ func (d *QueryData) streamRows(ctx context.Context, rowChan chan *proto.Row, don
 }
 
 func (d *QueryData) streamRow(row *proto.Row) {
+	// a nil row indicates the end of the data - always include the full set of warnings in this response
 	resp := &proto.ExecuteResponse{
-		Row: row,
-		Metadata: &proto.QueryMetadata{
-			HydrateCalls: d.queryStatus.hydrateCalls,
-			// only 1 of these will be non zero
-			RowsFetched: d.queryStatus.rowsStreamed + d.queryStatus.cachedRowsFetched,
-			CacheHit:    d.queryStatus.cachedRowsFetched > 0,
-		},
+		Row:        row,
+		Metadata:   d.getQueryMetadata(row == nil),
 		Connection: d.Connection.Name,
 	}
 
 	d.outputChan <- resp
 }
 
-func (d *QueryData) streamError(err error) {
-	log.Printf("[WARN] QueryData StreamError %v (%s)", err, d.connectionCallId)
-	d.errorChan <- sperr.WrapWithMessage(err, d.Connection.Name)
-}
+// streamHydrateStats sends a response with no row, containing the query metadata and hydrate stats
+// this is sent at the end of the query execution, if requested by the client
+func (d *QueryData) streamHydrateStats() {
+	metadata := d.getQueryMetadata(true)
+	metadata.HydrateStats = d.queryStatus.stats.toProto()
+	resp := &proto.ExecuteResponse{
+		Metadata:   metadata,
+		Connection: d.Connection.Name,
+	}
 
-// TODO KAI this seems to get called even after cancellation
-// execute necessary hydrate calls to populate row data
-func (d *QueryData) buildRowAsync(ctx context.Context, rowData *rowData, rowChan chan *proto.Row, wg *sync.WaitGroup, sem *semaphore.Weighted, prevRowWg *sync.WaitGroup) {
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				log.Printf("[INFO] buildRowAsync recovered panic %v (%s)", r, d.connectionCallId)
-				d.streamError(helpers.ToError(r))
-			}
-			wg.Done()
-			sem.Release(1)
-		}()
-		if rowData == nil {
-			log.Printf("[INFO] buildRowAsync nil rowData - streaming nil row (%s)", d.connectionCallId)
-			rowChan <- nil
-			return
-		}
+	d.outputChan <- resp
+}
 
-		// delegate the work to a row object
-		row, err := rowData.getRow(ctx)
-		if err != nil {
-			log.Printf("[WARN] getRow failed with error %v", err)
-			d.streamError(err)
-		} else {
-			if row != nil {
-				// remove reserved columns
-				d.removeReservedColumns(row)
-				// NOTE: add the Steampipecontext data to the row
-				d.addContextData(row, rowData)
-			}
-			// if ordering is being applied, wait until prev row is ready to ensure ordering
-			if len(d.QueryContext.SortOrder) > 0 && prevRowWg != nil {
-				prevRowWg.Wait()
-			}
+// getQueryMetadata returns the query metadata to send with a response
+// the warnings are only included if they have changed since they were last sent, or if this is the final response
+func (d *QueryData) getQueryMetadata(final bool) *proto.QueryMetadata {
+	metadata := &proto.QueryMetadata{
+		HydrateCalls: d.queryStatus.hydrateCalls,
+		// only 1 of these will be non zero
+		RowsFetched: d.queryStatus.rowsStreamed.Load() + atomic.LoadInt64(&d.queryStatus.cachedRowsFetched),
+		CacheHit:    d.queryStatus.cachedRowsFetched > 0,
+	}
+	if final {
+		metadata.Warnings, metadata.WarningsOmitted = d.queryStatus.warnings.toProto()
+		metadata.MatrixItems = d.queryStatus.matrixItems.toProto()
+	} else {
+		metadata.Warnings, metadata.WarningsOmitted = d.queryStatus.warnings.changes()
+	}
+	return metadata
+}
 
-			rowChan <- row
-			// close our own wait group
-			rowData.orderingWg.Done()
-		}
-	}()
+func (d *QueryData) streamError(err error) {
+	log.Printf("[WARN] QueryData StreamError %v (%s)", err, d.connectionCallId)
+	d.errorChan <- sperr.WrapWithMessage(err, d.Connection.Name)
 }
 
 func (d *QueryData) addContextData(row *proto.Row, rowData *rowData) {
@@ -902,21 +888,18 @@ func (d *QueryData) addContextData(row *proto.Row, rowData *rowData) {
 	row.Columns[deprecatedContextColumnName] = &proto.Column{Value: &proto.Column_JsonValue{JsonValue: jsonValue}}
 }
 
-func (d *QueryData) waitForRowsToComplete(rowWg *sync.WaitGroup, rowChan chan *proto.Row) {
-	rowWg.Wait()
-	logging.DisplayProfileData(10 * time.Millisecond)
-	close(rowChan)
-}
-
 // build a map of all quals to include in the cache key
 // this will include all key column quals, and also any quals which were used to filter the matrix items
 func (d *QueryData) getCacheQualMap() map[string]*proto.Quals {
 	res := d.Quals.ToProtoQualMap()
-	// now add in any additional (non-keycolumn) quals which were used to folter the matrix
+	// now add in all quals which were used to filter the matrix
+	// NOTE: this includes quals for key columns which were not part of the key column quals
+	// (e.g. a LIKE qual for a matrix column which is also an '=' key column)
+	// as the pruned set of matrix items depends on all of these quals
 	for _, col := range d.filteredMatrixColumns {
-		if _, ok := res[col]; !ok {
-			log.Printf("[TRACE] getCacheQualMap - adding non-key column qual %s as it was used to filter the matrix items", col)
-			res[col] = d.QueryContext.UnsafeQuals[col]
+		if matrixQuals, ok := d.QueryContext.UnsafeQuals[col]; ok {
+			log.Printf("[TRACE] getCacheQualMap - adding quals for column %s as they were used to filter the matrix items", col)
+			res[col] = matrixQuals
 		}
 	}
 	return res
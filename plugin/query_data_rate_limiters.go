@@ -23,6 +23,9 @@ func (d *QueryData) initialiseRateLimiters() {
 
 	// populate the rate limiters for the hydrate calls
 	d.resolveHydrateRateLimiters()
+
+	// now we have resolved the scope values, populate the circuit breakers
+	d.resolveCircuitBreakers()
 }
 
 // resolve the scope values for a given hydrate call
@@ -84,7 +87,7 @@ func (d *QueryData) resolveFetchRateLimiters() error {
 	// otherwise this is a list
 
 	// is there a parent-child hydrate?
-	if d.Table.List.ParentHydrate != nil {
+	if len(d.Table.List.parentChain) > 0 {
 		// it is a parent child list
 		return d.resolveParentChildRateLimiters()
 	}
@@ -108,14 +111,19 @@ func (d *QueryData) resolveParentChildRateLimiters() error {
 
 	// NOTE: RateLimit and ParentRateLimit cannot be nil as they are initialized to an empty struct if needed
 
-	// resolve the parent hydrate rate limiter
-	parentRateLimiter, err := d.plugin.getHydrateCallRateLimiter(d.Table.List.ParentTags, d)
-	if err != nil {
-		log.Printf("[WARN] resolveParentChildRateLimiters: %s: getHydrateCallRateLimiter failed: %s (%s)", d.Table.List.namedParentHydrate.Name, err.Error(), d.connectionCallId)
-		return err
+	// resolve the rate limiter for each level of the parent hydrate chain
+	parentChain := d.Table.List.parentChain
+	d.fetchLimiters.parentListRateLimiters = make([]*rate_limiter.MultiLimiter, len(parentChain))
+	for i, parent := range parentChain {
+		parentRateLimiter, err := d.plugin.getHydrateCallRateLimiter(parent.Tags, d)
+		if err != nil {
+			log.Printf("[WARN] resolveParentChildRateLimiters: %s: getHydrateCallRateLimiter failed: %s (%s)", parent.namedHydrate.Name, err.Error(), d.connectionCallId)
+			return err
+		}
+		d.fetchLimiters.parentListRateLimiters[i] = parentRateLimiter
 	}
-	// assign the parent rate limiter to d.fetchLimiters
-	d.fetchLimiters.rateLimiter = parentRateLimiter
+	// assign the outermost parent rate limiter to d.fetchLimiters
+	d.fetchLimiters.rateLimiter = d.fetchLimiters.parentListRateLimiters[0]
 
 	// resolve the child  hydrate rate limiter
 	childRateLimiter, err := d.plugin.getHydrateCallRateLimiter(d.Table.List.Tags, d)
@@ -146,6 +154,7 @@ func (d *QueryData) setListMetadata(fetchDelay time.Duration) {
 		ScopeValues:  d.fetchLimiters.rateLimiter.ScopeValues,
 		DelayMs:      fetchDelay.Milliseconds(),
 	}
+	d.getHydrateStats(d.listHydrate.Name).onRateLimit(fetchDelay, fetchMetadata.RateLimiters)
 	if d.childHydrate.empty() {
 		fetchMetadata.Type = string(fetchTypeList)
 		d.fetchMetadata = fetchMetadata
@@ -169,6 +178,48 @@ func (d *QueryData) setGetLimiterMetadata(fetchDelay time.Duration) {
 		ScopeValues:  d.fetchLimiters.rateLimiter.ScopeValues,
 		DelayMs:      fetchDelay.Milliseconds(),
 	}
+	d.getHydrateStats(d.fetchMetadata.FuncName).onRateLimit(fetchDelay, d.fetchMetadata.RateLimiters)
+}
+
+// resolve the circuit breakers for the fetch call and hydrate calls, using the rate limiter scope values
+func (d *QueryData) resolveCircuitBreakers() {
+	var fetchCircuitBreakerConfig *CircuitBreakerConfig
+	if d.FetchType == fetchTypeGet {
+		fetchCircuitBreakerConfig = d.Table.Get.CircuitBreakerConfig
+	} else {
+		fetchCircuitBreakerConfig = d.Table.List.CircuitBreakerConfig
+	}
+	if d.fetchLimiters.rateLimiter != nil {
+		d.fetchCircuitBreaker = d.plugin.circuitBreakers.getOrCreate(fetchCircuitBreakerConfig, d.fetchLimiters.rateLimiter.ScopeValues)
+	}
+
+	// for a parent-child list, each level of the parent chain has its own breaker, and the child list call uses the list breaker
+	if d.FetchType == fetchTypeList && len(d.fetchLimiters.parentListRateLimiters) > 0 {
+		parentChain := d.Table.List.parentChain
+		d.parentListCircuitBreakers = make([]*circuitBreaker, len(parentChain))
+		for i, parent := range parentChain {
+			if parentRateLimiter := d.fetchLimiters.parentListRateLimiters[i]; parentRateLimiter != nil {
+				d.parentListCircuitBreakers[i] = d.plugin.circuitBreakers.getOrCreate(parent.CircuitBreakerConfig, parentRateLimiter.ScopeValues)
+			}
+		}
+		// the fetch call is the outermost parent list call
+		d.fetchCircuitBreaker = d.parentListCircuitBreakers[0]
+		if d.fetchLimiters.childListRateLimiter != nil {
+			d.childListCircuitBreaker = d.plugin.circuitBreakers.getOrCreate(d.Table.List.CircuitBreakerConfig, d.fetchLimiters.childListRateLimiter.ScopeValues)
+		}
+	}
+
+	for _, h := range d.hydrateCalls {
+		h.initialiseCircuitBreaker()
+	}
+}
+
+// parentListCircuitBreaker returns the circuit breaker (if any) for the given level of the parent list chain
+func (d *QueryData) parentListCircuitBreaker(level int) *circuitBreaker {
+	if level >= len(d.parentListCircuitBreakers) {
+		return nil
+	}
+	return d.parentListCircuitBreakers[level]
 }
 
 func (d *QueryData) resolveHydrateRateLimiters() error {
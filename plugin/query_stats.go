@@ -0,0 +1,171 @@
+package plugin
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+// the maximum number of call durations sampled for each hydrate function (used to calculate the p95 duration)
+const maxHydrateDurationSamples = 1000
+
+// queryStats collects per hydrate function statistics for a query
+type queryStats struct {
+	hydrateStats map[string]*hydrateStats
+	mut          sync.Mutex
+}
+
+func newQueryStats() *queryStats {
+	return &queryStats{
+		hydrateStats: make(map[string]*hydrateStats),
+	}
+}
+
+// get returns the stats for the given hydrate function, creating them if necessary
+func (s *queryStats) get(funcName string) *hydrateStats {
+	if s == nil {
+		return nil
+	}
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	res, ok := s.hydrateStats[funcName]
+	if !ok {
+		res = &hydrateStats{funcName: funcName}
+		s.hydrateStats[funcName] = res
+	}
+	return res
+}
+
+// toProto returns the stats for all hydrate functions, sorted by function name
+func (s *queryStats) toProto() []*proto.HydrateStats {
+	if s == nil {
+		return nil
+	}
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	res := make([]*proto.HydrateStats, 0, len(s.hydrateStats))
+	for _, h := range s.hydrateStats {
+		res = append(res, h.toProto())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].FunctionName < res[j].FunctionName })
+	return res
+}
+
+// hydrateStats are the statistics for a single hydrate function
+// NOTE: a nil hydrateStats is valid - all methods are no-ops
+type hydrateStats struct {
+	funcName       string
+	callType       string
+	calls          int64
+	totalDuration  time.Duration
+	retries        int64
+	ignoredErrors  int64
+	rateLimitDelay time.Duration
+	rateLimiters   []string
+	// a reservoir sample of call durations
+	durations []time.Duration
+	mut       sync.Mutex
+}
+
+// onCall records a completed call (including any retries)
+func (h *hydrateStats) onCall(callType string, duration time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.callType = callType
+	h.calls++
+	h.totalDuration += duration
+	if len(h.durations) < maxHydrateDurationSamples {
+		h.durations = append(h.durations, duration)
+	} else if i := rand.Int63n(h.calls); i < maxHydrateDurationSamples {
+		h.durations[i] = duration
+	}
+}
+
+func (h *hydrateStats) onRetry() {
+	if h == nil {
+		return
+	}
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.retries++
+}
+
+func (h *hydrateStats) onIgnoredError() {
+	if h == nil {
+		return
+	}
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.ignoredErrors++
+}
+
+func (h *hydrateStats) onRateLimit(delay time.Duration, rateLimiters []string) {
+	if h == nil {
+		return
+	}
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	h.rateLimitDelay += delay
+	for _, l := range rateLimiters {
+		if !helpers.StringSliceContains(h.rateLimiters, l) {
+			h.rateLimiters = append(h.rateLimiters, l)
+		}
+	}
+}
+
+func (h *hydrateStats) toProto() *proto.HydrateStats {
+	h.mut.Lock()
+	defer h.mut.Unlock()
+	return &proto.HydrateStats{
+		FunctionName:       h.funcName,
+		Type:               h.callType,
+		Calls:              h.calls,
+		TotalDurationMs:    h.totalDuration.Milliseconds(),
+		P95DurationMs:      h.p95Duration().Milliseconds(),
+		Retries:            h.retries,
+		IgnoredErrors:      h.ignoredErrors,
+		RateLimiterDelayMs: h.rateLimitDelay.Milliseconds(),
+		RateLimiters:       append([]string(nil), h.rateLimiters...),
+	}
+}
+
+// NOTE: the lock must be held by the caller
+func (h *hydrateStats) p95Duration() time.Duration {
+	if len(h.durations) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), h.durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	// nearest rank
+	idx := (len(sorted)*95+99)/100 - 1
+	return sorted[idx]
+}
+
+// getHydrateStats returns the stats for the given hydrate function (nil if stats are not being collected)
+func (d *QueryData) getHydrateStats(funcName string) *hydrateStats {
+	if d == nil || d.queryStatus == nil {
+		return nil
+	}
+	return d.queryStatus.stats.get(funcName)
+}
+
+// getHydrateCallType returns the type of the given hydrate function call - get, list, parentHydrate or hydrate
+func (d *QueryData) getHydrateCallType(funcName string) string {
+	switch {
+	case d.FetchType == fetchTypeGet && d.Table.Get != nil && funcName == d.Table.Get.namedHydrate.Name:
+		return string(fetchTypeGet)
+	case d.Table.List != nil && d.Table.List.getParentLevel(funcName) != -1:
+		return "parentHydrate"
+	case funcName == d.listHydrate.Name || funcName == d.childHydrate.Name:
+		return string(fetchTypeList)
+	}
+	return "hydrate"
+}
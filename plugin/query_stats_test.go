@@ -0,0 +1,54 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestQueryStats(t *testing.T) {
+	d := &QueryData{
+		Table:       &Table{Name: "test"},
+		Connection:  &Connection{Name: "c1"},
+		queryStatus: newQueryStatus(nil),
+		FetchType:   fetchTypeList,
+	}
+	d.setListCalls(newNamedHydrateFunc(listHydrate), namedHydrateFunc{})
+
+	// 100 calls, taking 1..100ms
+	for i := 1; i <= 100; i++ {
+		d.getHydrateStats("getThing").onCall(d.getHydrateCallType("getThing"), time.Duration(i)*time.Millisecond)
+	}
+	d.getHydrateStats("getThing").onRetry()
+	d.getHydrateStats("getThing").onRateLimit(20*time.Millisecond, []string{"limiter1"})
+	d.getHydrateStats("getThing").onRateLimit(30*time.Millisecond, []string{"limiter1", "limiter2"})
+	d.onHydrateErrorIgnored(context.Background(), "getThing", errors.New("not found"))
+
+	listName := d.listHydrate.Name
+	d.getHydrateStats(listName).onCall(d.getHydrateCallType(listName), time.Second)
+
+	stats := d.queryStatus.stats.toProto()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 hydrate stats, got %d", len(stats))
+	}
+	// stats are sorted by function name
+	getThing := stats[0]
+	if getThing.FunctionName != "getThing" {
+		getThing = stats[1]
+	}
+	if getThing.Type != "hydrate" || getThing.Calls != 100 || getThing.TotalDurationMs != 5050 || getThing.P95DurationMs != 95 {
+		t.Errorf("unexpected call stats %v", getThing)
+	}
+	if getThing.Retries != 1 || getThing.IgnoredErrors != 1 || getThing.RateLimiterDelayMs != 50 || len(getThing.RateLimiters) != 2 {
+		t.Errorf("unexpected retry/ignore/rate limit stats %v", getThing)
+	}
+
+	list := stats[0]
+	if list.FunctionName != listName {
+		list = stats[1]
+	}
+	if list.Type != string(fetchTypeList) || list.Calls != 1 || list.P95DurationMs != 1000 {
+		t.Errorf("unexpected list stats %v", list)
+	}
+}
@@ -3,15 +3,29 @@ package plugin
 import (
 	"context"
 	"math"
+	"sync/atomic"
 )
 
 type queryStatus struct {
 	rowsRequired      int64
-	rowsStreamed      int64
+	rowsStreamed      atomic.Int64
 	hydrateCalls      int64
 	cachedRowsFetched int64
+	// errors which were ignored during the query
+	warnings *queryWarnings
+	// per hydrate function statistics
+	stats *queryStats
+	// the result of fetching each matrix item
+	matrixItems *matrixItemStatuses
 	// flag which is true when we have streamed enough rows (or the context is cancelled)
-	StreamingComplete bool
+	StreamingComplete atomic.Bool
+	// if there is an explode hydrate call, the number of rows produced by each item is not known,
+	// so the limit applies to the exploded rows rather than the items streamed
+	// - explodedRowsRequired is the limit for the exploded rows (zero if there is no limit, or no explode hydrate call)
+	explodedRowsRequired int64
+	explodedRowsSent     atomic.Int64
+	// flag which is set when the limit has been reached for the exploded rows
+	explodedRowLimitReached atomic.Bool
 }
 
 func newQueryStatus(limit *int64) *queryStatus {
@@ -21,6 +35,9 @@ func newQueryStatus(limit *int64) *queryStatus {
 	}
 	return &queryStatus{
 		rowsRequired: rowsRequired,
+		warnings:     newQueryWarnings(),
+		stats:        newQueryStats(),
+		matrixItems:  newMatrixItemStatuses(),
 	}
 }
 
@@ -28,11 +45,37 @@ func newQueryStatus(limit *int64) *queryStatus {
 //   - if no limit has been parsed from the query, this will return math.MaxInt32
 //     (meaning an unknown number of rows remain)
 //   - if there is a limit, it will return the number of rows required to reach this limit
+//     (if there is an explode hydrate call, this will return zero once the limit has been reached for the exploded rows)
 //   - if  the context has been cancelled, it will return zero
 func (s *queryStatus) RowsRemaining(ctx context.Context) int64 {
-	if IsCancelled(ctx) {
+	if IsCancelled(ctx) || s.explodedRowLimitReached.Load() {
 		return 0
 	}
-	rowsRemaining := s.rowsRequired - s.rowsStreamed
+	rowsRemaining := s.rowsRequired - s.rowsStreamed.Load()
 	return rowsRemaining
 }
+
+// applyLimitToExplodedRows moves the limit from the items streamed to the exploded rows
+// this is used if there is an explode hydrate call, as the number of rows produced by each item is not known
+func (s *queryStatus) applyLimitToExplodedRows() {
+	// if there is no limit (or the limit is zero so no items are required), there is nothing to do
+	if s.rowsRequired == math.MaxInt32 || s.rowsRequired == 0 {
+		return
+	}
+	s.explodedRowsRequired = s.rowsRequired
+	s.rowsRequired = math.MaxInt32
+}
+
+// onRowSent is called before a row is sent - it returns false if the row should not be sent
+// as the limit has been reached for the exploded rows
+func (s *queryStatus) onRowSent() bool {
+	if s.explodedRowsRequired == 0 {
+		return true
+	}
+	rowsSent := s.explodedRowsSent.Add(1)
+	if rowsSent >= s.explodedRowsRequired {
+		// no more items are required
+		s.explodedRowLimitReached.Store(true)
+	}
+	return rowsSent <= s.explodedRowsRequired
+}
@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+// the maximum number of distinct warnings returned for a query
+const maxQueryWarnings = 20
+
+// queryWarnings records the errors which were ignored during a query,
+// so they can be returned to the client in the QueryMetadata
+//
+// warnings are deduplicated by table, connection, hydrate function, matrix item and message
+// - repeated occurrences increment the warning count
+type queryWarnings struct {
+	warnings []*proto.QueryWarning
+	lookup   map[string]*proto.QueryWarning
+	// the number of warnings which were not recorded as the cap was reached
+	omitted int64
+	// snapshot of the warnings, returned by toProto - rebuilt when the warnings change
+	snapshot []*proto.QueryWarning
+	dirty    bool
+	// are there changes which have not been returned by changes
+	unsent bool
+	mut    sync.Mutex
+}
+
+func newQueryWarnings() *queryWarnings {
+	return &queryWarnings{
+		lookup: make(map[string]*proto.QueryWarning),
+	}
+}
+
+func (w *queryWarnings) add(warning *proto.QueryWarning) {
+	key := queryWarningKey(warning)
+
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if existing, ok := w.lookup[key]; ok {
+		existing.Count++
+		w.dirty = true
+		w.unsent = true
+		return
+	}
+	if len(w.warnings) >= maxQueryWarnings {
+		if w.omitted == 0 {
+			log.Printf("[INFO] query has more than %d distinct warnings - further warnings will not be returned", maxQueryWarnings)
+		}
+		w.omitted++
+		w.unsent = true
+		return
+	}
+	warning.Count = 1
+	w.warnings = append(w.warnings, warning)
+	w.lookup[key] = warning
+	w.dirty = true
+	w.unsent = true
+}
+
+// toProto returns a copy of the current warnings, and the number of warnings which were omitted
+// (a copy is required as the warnings may be updated while a response is being sent)
+func (w *queryWarnings) toProto() ([]*proto.QueryWarning, int64) {
+	if w == nil {
+		return nil, 0
+	}
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	w.unsent = false
+	return w.getSnapshot(), w.omitted
+}
+
+// changes returns a copy of the current warnings, and the number of warnings which were omitted,
+// if they have changed since they were last returned - otherwise it returns nil
+// this is used to avoid sending the full set of warnings with every row
+func (w *queryWarnings) changes() ([]*proto.QueryWarning, int64) {
+	if w == nil {
+		return nil, 0
+	}
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if !w.unsent {
+		return nil, 0
+	}
+	w.unsent = false
+	return w.getSnapshot(), w.omitted
+}
+
+// getSnapshot returns the warnings snapshot, rebuilding it if the warnings have changed
+// NOTE: the mutex must be held by the caller
+func (w *queryWarnings) getSnapshot() []*proto.QueryWarning {
+	if w.dirty {
+		w.snapshot = make([]*proto.QueryWarning, len(w.warnings))
+		for i, warning := range w.warnings {
+			w.snapshot[i] = &proto.QueryWarning{
+				Table:      warning.Table,
+				Connection: warning.Connection,
+				Hydrate:    warning.Hydrate,
+				MatrixItem: warning.MatrixItem,
+				Message:    warning.Message,
+				Count:      warning.Count,
+			}
+		}
+		w.dirty = false
+	}
+	return w.snapshot
+}
+
+func queryWarningKey(warning *proto.QueryWarning) string {
+	matrixKeys := make([]string, 0, len(warning.MatrixItem))
+	for k, v := range warning.MatrixItem {
+		matrixKeys = append(matrixKeys, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(matrixKeys)
+	return strings.Join([]string{warning.Table, warning.Connection, warning.Hydrate, strings.Join(matrixKeys, ","), warning.Message}, "\x00")
+}
+
+// onHydrateErrorIgnored records an ignored hydrate error in the query warnings and stats
+func (d *QueryData) onHydrateErrorIgnored(ctx context.Context, hydrateName string, err error) {
+	d.addWarning(ctx, hydrateName, err)
+	d.getHydrateStats(hydrateName).onIgnoredError()
+}
+
+// addWarning records an ignored hydrate error as a query warning
+func (d *QueryData) addWarning(ctx context.Context, hydrateName string, err error) {
+	if d == nil || d.queryStatus == nil || d.queryStatus.warnings == nil {
+		return
+	}
+	warning := &proto.QueryWarning{
+		Hydrate: hydrateName,
+		Message: err.Error(),
+	}
+	if d.Table != nil {
+		warning.Table = d.Table.Name
+	}
+	if d.Connection != nil {
+		warning.Connection = d.Connection.Name
+	}
+	if matrixItem := getQueryMatrixItem(ctx, d); len(matrixItem) > 0 {
+		warning.MatrixItem = make(map[string]string, len(matrixItem))
+		for k, v := range matrixItem {
+			warning.MatrixItem[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	d.queryStatus.warnings.add(warning)
+}
@@ -0,0 +1,66 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestQueryWarnings(t *testing.T) {
+	d := &QueryData{
+		Table:       &Table{Name: "aws_s3_bucket"},
+		Connection:  &Connection{Name: "aws"},
+		queryStatus: newQueryStatus(nil),
+	}
+	accessDenied := errors.New("AccessDenied")
+
+	// identical warnings are deduplicated
+	for i := 0; i < 3; i++ {
+		d.addWarning(context.Background(), "getBucketPolicy", accessDenied)
+	}
+	// warnings for a different matrix item are distinct
+	d.matrixItem = map[string]interface{}{"region": "us-east-1"}
+	d.addWarning(context.Background(), "getBucketPolicy", accessDenied)
+
+	warnings, _ := d.queryStatus.warnings.toProto()
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %d", len(warnings))
+	}
+	if warnings[0].Count != 3 || warnings[0].Hydrate != "getBucketPolicy" || warnings[0].Table != "aws_s3_bucket" || warnings[0].Connection != "aws" {
+		t.Errorf("unexpected warning %v", warnings[0])
+	}
+	if warnings[1].Count != 1 || warnings[1].MatrixItem["region"] != "us-east-1" {
+		t.Errorf("unexpected warning %v", warnings[1])
+	}
+
+	// the returned warnings are a snapshot
+	d.addWarning(context.Background(), "getBucketPolicy", accessDenied)
+	if warnings[1].Count != 1 {
+		t.Error("expected returned warnings not to be updated")
+	}
+	if warnings, _ := d.queryStatus.warnings.toProto(); warnings[1].Count != 2 {
+		t.Errorf("expected count 2, got %d", warnings[1].Count)
+	}
+
+	// warnings are only returned by changes if they have changed since they were last returned
+	if warnings, _ := d.queryStatus.warnings.changes(); warnings != nil {
+		t.Errorf("expected no changed warnings, got %v", warnings)
+	}
+	d.addWarning(context.Background(), "getBucketPolicy", accessDenied)
+	if warnings, _ := d.queryStatus.warnings.changes(); len(warnings) != 2 {
+		t.Errorf("expected 2 changed warnings, got %d", len(warnings))
+	}
+
+	// distinct warnings are capped
+	for i := 0; i < maxQueryWarnings*2; i++ {
+		d.addWarning(context.Background(), "getBucketPolicy", fmt.Errorf("error %d", i))
+	}
+	warnings, omitted := d.queryStatus.warnings.toProto()
+	if len(warnings) != maxQueryWarnings {
+		t.Errorf("expected %d warnings, got %d", maxQueryWarnings, len(warnings))
+	}
+	if expected := int64(maxQueryWarnings + 2); omitted != expected {
+		t.Errorf("expected %d omitted warnings, got %d", expected, omitted)
+	}
+}
@@ -0,0 +1,72 @@
+package plugin
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryAfterProvider is implemented by errors which carry a server-provided retry delay,
+// for example the value of an HTTP Retry-After header.
+//
+// If a [HydrateFunc] returns an error implementing this interface (or wrapping one), and the error
+// is retried (as determined by the [RetryConfig]), the returned duration is used as the next retry delay
+// in place of the delay calculated by the backoff algorithm.
+type RetryAfterProvider interface {
+	RetryAfter() time.Duration
+}
+
+/*
+RetryAfterError wraps an error returned by a [HydrateFunc] with a server-provided retry delay.
+
+	resp, err := client.DescribeThings(ctx, input)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			return nil, plugin.NewRetryAfterError(err, parseRetryAfter(resp.Header.Get("Retry-After")))
+		}
+		return nil, err
+	}
+
+NOTE: RetryAfterError does not force a retry - the wrapped error must still satisfy the
+ShouldRetryErrorFunc of the [RetryConfig]. The underlying error is available using errors.As/errors.Is.
+*/
+type RetryAfterError struct {
+	Err   error
+	Delay time.Duration
+}
+
+// NewRetryAfterError returns a [RetryAfterError] wrapping err, requesting that the next retry is made after delay
+func NewRetryAfterError(err error, delay time.Duration) *RetryAfterError {
+	return &RetryAfterError{
+		Err:   err,
+		Delay: delay,
+	}
+}
+
+func (e *RetryAfterError) Error() string {
+	if e.Err == nil {
+		return "retry after " + e.Delay.String()
+	}
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter implements RetryAfterProvider
+func (e *RetryAfterError) RetryAfter() time.Duration {
+	return e.Delay
+}
+
+// getRetryAfter returns the retry delay carried by err, if any
+func getRetryAfter(err error) (time.Duration, bool) {
+	var provider RetryAfterProvider
+	if err == nil || !errors.As(err, &provider) {
+		return 0, false
+	}
+	delay := provider.RetryAfter()
+	if delay <= 0 {
+		return 0, false
+	}
+	return delay, true
+}
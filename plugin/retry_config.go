@@ -43,6 +43,18 @@ Retry errors from a HydrateFunc that has a HydrateConfig:
 		...
 	},
 
+Retry throttling errors with jittered backoff, so that many concurrent calls do not retry in lockstep:
+
+	RetryConfig: &plugin.RetryConfig{
+		ShouldRetryErrorFunc: shouldRetryError,
+		BackoffAlgorithm:     "Exponential",
+		Jitter:               "Full",
+		CappedDuration:       5000,
+	},
+
+If the error returned by the HydrateFunc carries a server-provided delay (see [RetryAfterError]),
+that delay is used for the next retry in place of the backoff delay.
+
 Retry errors that may occur in many HydrateFuncs:
 
 	DefaultIgnoreConfig: &plugin.DefaultIgnoreConfig{
@@ -61,12 +73,21 @@ type RetryConfig struct {
 	MaxAttempts int64
 	// Algorithm for the backoff. Supported values: Fibonacci, Exponential, and Constant. Default set to Fibonacci.
 	BackoffAlgorithm string
+	// Jitter applied to the backoff, so concurrent calls do not retry in lockstep. Supported values: None, Full and Decorrelated. Default set to None.
+	//  - Full: each delay is a random value between zero and the backoff delay.
+	//  - Decorrelated: each delay is a random value between RetryInterval and 3 times the previous delay (this replaces BackoffAlgorithm).
+	// NOTE: list calls only use the backoff settings of the retry config (MaxAttempts, BackoffAlgorithm, RetryInterval,
+	// CappedDuration and MaxDuration) if Jitter is set - otherwise they use the default backoff.
+	Jitter string
 	// Starting interval. Default set to 100ms.
 	RetryInterval int64
 	// Set a maximum on the duration (in ms) returned from the next backoff.
 	CappedDuration int64
 	// Sets a maximum on the total amount of time (in ms) a backoff should execute.
 	MaxDuration int64
+
+	// is this the retry config for a list call (errors cannot be retried after streaming has started)
+	isListRetryConfig bool
 }
 
 func (c *RetryConfig) String() string {
@@ -82,6 +103,7 @@ func (c *RetryConfig) String() string {
 func (c *RetryConfig) validate(table *Table) []string {
 	var res []string
 	validBackoffAlgorithm := []string{"Constant", "Exponential", "Fibonacci"}
+	validJitter := []string{"None", "Full", "Decorrelated"}
 
 	var tablePrefix string
 	if table != nil {
@@ -96,6 +118,10 @@ func (c *RetryConfig) validate(table *Table) []string {
 		res = append(res, fmt.Sprintf("%sBackoffAlgorithm value '%s' is not valid, it must be one of: %s", tablePrefix, c.BackoffAlgorithm, strings.Join(validBackoffAlgorithm, ",")))
 	}
 
+	if c.Jitter != "" && !helpers.StringSliceContains(validJitter, c.Jitter) {
+		res = append(res, fmt.Sprintf("%sJitter value '%s' is not valid, it must be one of: %s", tablePrefix, c.Jitter, strings.Join(validJitter, ",")))
+	}
+
 	return res
 }
 
@@ -123,12 +149,21 @@ func (c *RetryConfig) DefaultTo(other *RetryConfig) {
 
 // GetListRetryConfig  wraps the ShouldRetry function with an additional check of the rows streamed
 // (as we cannot retry errors in the list hydrate function after streaming has started)
+// the backoff settings are copied, so list calls honour the same attempt and backoff limits as hydrate calls
 func (c *RetryConfig) GetListRetryConfig() *RetryConfig {
-	listRetryConfig := &RetryConfig{}
+	listRetryConfig := &RetryConfig{
+		isListRetryConfig: true,
+		MaxAttempts:       c.MaxAttempts,
+		BackoffAlgorithm:  c.BackoffAlgorithm,
+		Jitter:            c.Jitter,
+		RetryInterval:     c.RetryInterval,
+		CappedDuration:    c.CappedDuration,
+		MaxDuration:       c.MaxDuration,
+	}
 	if c.ShouldRetryErrorFunc != nil {
 		listRetryConfig.ShouldRetryErrorFunc = func(ctx context.Context, d *QueryData, h *HydrateData, err error) bool {
-			if d.queryStatus.rowsStreamed != 0 {
-				log.Printf("[TRACE] shouldRetryError we have started streaming rows (%d) - return false", d.queryStatus.rowsStreamed)
+			if d.queryStatus.rowsStreamed.Load() != 0 {
+				log.Printf("[TRACE] shouldRetryError we have started streaming rows (%d) - return false", d.queryStatus.rowsStreamed.Load())
 				return false
 			}
 			res := c.ShouldRetryErrorFunc(ctx, d, h, err)
@@ -136,8 +171,8 @@ func (c *RetryConfig) GetListRetryConfig() *RetryConfig {
 		}
 	} else if c.ShouldRetryError != nil {
 		listRetryConfig.ShouldRetryErrorFunc = func(ctx context.Context, d *QueryData, h *HydrateData, err error) bool {
-			if d.queryStatus.rowsStreamed != 0 {
-				log.Printf("[TRACE] shouldRetryError we have started streaming rows (%d) - return false", d.queryStatus.rowsStreamed)
+			if d.queryStatus.rowsStreamed.Load() != 0 {
+				log.Printf("[TRACE] shouldRetryError we have started streaming rows (%d) - return false", d.queryStatus.rowsStreamed.Load())
 				return false
 			}
 			// call the legacy function
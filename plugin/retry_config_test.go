@@ -0,0 +1,27 @@
+package plugin
+
+import (
+	"testing"
+)
+
+func TestGetListRetryConfig(t *testing.T) {
+	// the backoff settings are always copied
+	tests := map[string]*RetryConfig{
+		"no jitter":   {MaxAttempts: 3, BackoffAlgorithm: "Constant", RetryInterval: 500, CappedDuration: 1000, MaxDuration: 5000},
+		"with jitter": {MaxAttempts: 3, BackoffAlgorithm: "Constant", Jitter: "Full", RetryInterval: 500, CappedDuration: 1000, MaxDuration: 5000},
+	}
+	for name, retryConfig := range tests {
+		listRetryConfig := retryConfig.GetListRetryConfig()
+		if listRetryConfig.MaxAttempts != retryConfig.MaxAttempts ||
+			listRetryConfig.BackoffAlgorithm != retryConfig.BackoffAlgorithm ||
+			listRetryConfig.Jitter != retryConfig.Jitter ||
+			listRetryConfig.RetryInterval != retryConfig.RetryInterval ||
+			listRetryConfig.CappedDuration != retryConfig.CappedDuration ||
+			listRetryConfig.MaxDuration != retryConfig.MaxDuration {
+			t.Errorf("test %s: expected the backoff settings to be copied, got %+v", name, listRetryConfig)
+		}
+		if !listRetryConfig.isListRetryConfig {
+			t.Errorf("test %s: expected a list retry config", name)
+		}
+	}
+}
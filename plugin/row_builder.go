@@ -0,0 +1,339 @@
+package plugin
+
+import (
+	"context"
+	"log"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/logging"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+)
+
+const (
+	// if a memory limit is set (GOMEMLIMIT), stop starting new rows when memory usage exceeds this fraction of the limit
+	memoryPressureThreshold = 0.9
+	// how often to recheck memory usage when applying backpressure
+	memoryBackpressureInterval = 10 * time.Millisecond
+)
+
+// rowBuildJob is a rowData item to build rows for, with its sequence number (used to preserve row ordering)
+type rowBuildJob struct {
+	seq     int64
+	rowData *rowData
+}
+
+// rowBuildResult contains the rows built for a rowBuildJob
+type rowBuildResult struct {
+	seq  int64
+	rows []*proto.Row
+}
+
+// rowBuilder builds rows from the rowData items streamed by the fetch call, using a bounded pool of workers
+//   - workers are started as required, up to STEAMPIPE_MAX_CONCURRENT_ROWS
+//   - at most this many rows are in flight at once (including built rows waiting to be streamed in order)
+//   - if STEAMPIPE_MAX_CONCURRENT_ROWS is zero, the number of workers (and rows in flight) is unlimited
+//   - if the query has a sort order, rows are streamed in the order the rowData items were received
+//   - if a memory limit is set (GOMEMLIMIT), no new rows are started while memory usage is close to the limit
+type rowBuilder struct {
+	d        *QueryData
+	rowChan  chan *proto.Row
+	doneChan chan bool
+	// should the rows be streamed in the order the rowData items were received
+	ordered bool
+
+	maxWorkers int
+	workers    int
+	workerWg   sync.WaitGroup
+	jobs       chan rowBuildJob
+	// built rows are sent to the results channel to be reordered (only used if ordered is true)
+	results chan rowBuildResult
+	// tokens limiting the number of rows in flight (nil if the number of rows in flight is unlimited)
+	inFlight chan struct{}
+	// the number of rows in flight
+	rowsInFlight atomic.Int64
+	// monitors memory usage if a memory limit is set (nil otherwise)
+	memory *memoryMonitor
+}
+
+func newRowBuilder(d *QueryData, rowChan chan *proto.Row, doneChan chan bool) *rowBuilder {
+	maxWorkers := rate_limiter.GetMaxConcurrentRows()
+	b := &rowBuilder{
+		d:          d,
+		rowChan:    rowChan,
+		doneChan:   doneChan,
+		ordered:    len(d.QueryContext.SortOrder) > 0,
+		maxWorkers: maxWorkers,
+		jobs:       make(chan rowBuildJob),
+		memory:     newMemoryMonitor(),
+	}
+	if maxWorkers > 0 {
+		b.inFlight = make(chan struct{}, maxWorkers)
+	}
+	if b.ordered {
+		b.results = make(chan rowBuildResult, max(maxWorkers, 0))
+	}
+	return b
+}
+
+// run reads rowData items from the rowDataChan and builds and streams the rows
+// when all rows have been streamed, the row channel is closed
+func (b *rowBuilder) run(ctx context.Context) {
+	var reorderComplete chan struct{}
+	if b.ordered {
+		reorderComplete = make(chan struct{})
+		go b.streamOrderedResults(reorderComplete)
+	}
+
+	complete := b.dispatch(ctx)
+
+	// no more jobs - wait for the workers (and the reordering) to finish
+	close(b.jobs)
+	b.workerWg.Wait()
+	if b.ordered {
+		close(b.results)
+		<-reorderComplete
+	}
+
+	// if all rows were built, close the row channel
+	// (if we were cancelled, streamRows has already returned)
+	if complete {
+		logging.DisplayProfileData(10 * time.Millisecond)
+		close(b.rowChan)
+	}
+}
+
+// dispatch sends each rowData item to a worker - returns whether all items were dispatched
+func (b *rowBuilder) dispatch(ctx context.Context) bool {
+	var seq int64
+	for {
+		// wait for either a rowData or a done signal
+		select {
+		case <-b.doneChan:
+			log.Printf("[INFO] rowBuilder done channel selected - quitting %s", b.d.Connection.Name)
+			return false
+		case rowData := <-b.d.rowDataChan:
+			logging.LogTime("got rowData - building row")
+			// is there any more data?
+			if rowData == nil {
+				log.Printf("[TRACE] rowData chan returned nil - wait for rows to complete (%s)", b.d.connectionCallId)
+				return true
+			}
+			// if memory usage is close to the limit, wait for rows in flight to complete
+			b.waitForMemory(ctx)
+
+			if !b.acquire(ctx) {
+				return false
+			}
+			if !b.submit(ctx, rowBuildJob{seq: seq, rowData: rowData}) {
+				b.release()
+				return false
+			}
+			seq++
+		}
+	}
+}
+
+// acquire a token for a row in flight, waiting if the maximum number of rows are in flight
+func (b *rowBuilder) acquire(ctx context.Context) bool {
+	if b.inFlight == nil {
+		b.rowsInFlight.Add(1)
+		return true
+	}
+	t := time.Now()
+	select {
+	case b.inFlight <- struct{}{}:
+	case <-ctx.Done():
+		log.Printf("[INFO] rowBuilder context cancelled waiting for rows to complete (%s)", b.d.connectionCallId)
+		b.d.errorChan <- ctx.Err()
+		return false
+	case <-b.doneChan:
+		return false
+	}
+	if time.Since(t) > 1*time.Millisecond {
+		log.Printf("[INFO] rowBuilder waited %dms to hydrate row (%s)", time.Since(t).Milliseconds(), b.d.connectionCallId)
+	}
+	// only count the row as in flight once the token has been acquired
+	b.rowsInFlight.Add(1)
+	return true
+}
+
+// release the token for a row in flight
+func (b *rowBuilder) release() {
+	b.rowsInFlight.Add(-1)
+	if b.inFlight != nil {
+		<-b.inFlight
+	}
+}
+
+// submit the job to an idle worker, starting a new worker if there are none idle (and we are below the max workers)
+func (b *rowBuilder) submit(ctx context.Context, job rowBuildJob) bool {
+	select {
+	case b.jobs <- job:
+		return true
+	default:
+	}
+	if b.maxWorkers <= 0 || b.workers < b.maxWorkers {
+		b.workers++
+		b.workerWg.Add(1)
+		go b.worker(ctx)
+	}
+	select {
+	case b.jobs <- job:
+		return true
+	case <-b.doneChan:
+		return false
+	}
+}
+
+func (b *rowBuilder) worker(ctx context.Context) {
+	defer b.workerWg.Done()
+	for job := range b.jobs {
+		rows := b.buildRows(ctx, job.rowData)
+		if b.ordered {
+			select {
+			case b.results <- rowBuildResult{seq: job.seq, rows: rows}:
+			case <-b.doneChan:
+			}
+			continue
+		}
+		b.sendRows(rows)
+		b.release()
+	}
+}
+
+// buildRows executes the hydrate calls for the rowData and builds its rows
+// if there is an error, this is streamed and no rows are returned
+func (b *rowBuilder) buildRows(ctx context.Context, rowData *rowData) (rows []*proto.Row) {
+	d := b.d
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[INFO] rowBuilder recovered panic %v (%s)", r, d.connectionCallId)
+			d.streamError(helpers.ToError(r))
+			rows = nil
+		}
+	}()
+
+	// delegate the work to a row object
+	// (an explode hydrate call may produce multiple rows)
+	rows, err := rowData.getRows(ctx)
+	if err != nil {
+		log.Printf("[WARN] getRows failed with error %v", err)
+		d.streamError(err)
+		return nil
+	}
+	for _, row := range rows {
+		if row != nil {
+			// remove reserved columns
+			d.removeReservedColumns(row)
+			// NOTE: add the Steampipecontext data to the row
+			d.addContextData(row, rowData)
+		}
+	}
+	// the rowData is no longer needed - return it to the pool
+	rowData.release()
+	return rows
+}
+
+// send the rows to the row channel
+// NOTE: nil rows (i.e. rows whose column values failed to build - the error has already been streamed) are skipped,
+// as a nil row indicates to streamRows that all rows have been streamed
+func (b *rowBuilder) sendRows(rows []*proto.Row) {
+	for _, row := range rows {
+		if row == nil {
+			continue
+		}
+		// if there is an explode hydrate call, the limit is applied to the rows sent
+		if !b.d.queryStatus.onRowSent() {
+			return
+		}
+		select {
+		case b.rowChan <- row:
+		case <-b.doneChan:
+			return
+		}
+	}
+}
+
+// streamOrderedResults reads the built rows from the results channel and streams them in sequence order
+func (b *rowBuilder) streamOrderedResults(complete chan struct{}) {
+	defer close(complete)
+	// built rows waiting for earlier rows to complete
+	// (the size of this is bounded by the number of rows in flight)
+	pending := make(map[int64][]*proto.Row)
+	var next int64
+	for result := range b.results {
+		pending[result.seq] = result.rows
+		for {
+			rows, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			b.sendRows(rows)
+			b.release()
+			next++
+		}
+	}
+}
+
+// wait while memory usage is close to the limit and there are rows in flight
+func (b *rowBuilder) waitForMemory(ctx context.Context) {
+	if b.memory == nil || !b.memory.underPressure() {
+		return
+	}
+	t := time.Now()
+	log.Printf("[INFO] rowBuilder memory usage is close to the limit - waiting for %d rows in flight to complete (%s)", b.rowsInFlight.Load(), b.d.connectionCallId)
+	// if there are no rows in flight there is nothing to wait for
+	for b.rowsInFlight.Load() > 0 && b.memory.underPressure() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.doneChan:
+			return
+		case <-time.After(memoryBackpressureInterval):
+		}
+	}
+	log.Printf("[INFO] rowBuilder waited %dms for memory usage to reduce (%s)", time.Since(t).Milliseconds(), b.d.connectionCallId)
+}
+
+// memoryMonitor reports whether memory usage is close to the soft memory limit (GOMEMLIMIT)
+// NOTE: this is not safe for concurrent use
+type memoryMonitor struct {
+	threshold uint64
+	samples   []metrics.Sample
+}
+
+// newMemoryMonitor returns a memoryMonitor, or nil if no memory limit is set
+func newMemoryMonitor() *memoryMonitor {
+	if !memoryLimitSet() {
+		return nil
+	}
+	return &memoryMonitor{
+		threshold: uint64(float64(debug.SetMemoryLimit(-1)) * memoryPressureThreshold),
+		samples: []metrics.Sample{
+			{Name: "/memory/classes/total:bytes"},
+			{Name: "/memory/classes/heap/released:bytes"},
+		},
+	}
+}
+
+// memoryLimitSet returns whether a soft memory limit has been set (i.e. using GOMEMLIMIT)
+func memoryLimitSet() bool {
+	// passing a negative value returns the current limit without changing it
+	limit := debug.SetMemoryLimit(-1)
+	return limit > 0 && limit != math.MaxInt64
+}
+
+func (m *memoryMonitor) underPressure() bool {
+	metrics.Read(m.samples)
+	// the memory limit applies to the total memory mapped by the runtime, less memory released to the OS
+	used := m.samples[0].Value.Uint64() - m.samples[1].Value.Uint64()
+	return used >= m.threshold
+}
@@ -0,0 +1,336 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const testRowBuilderHydrateName = "listItems"
+
+// newTestRowBuilderQueryData creates a QueryData with a single column populated by the given transform
+func newTestRowBuilderQueryData(sorted bool, transformFunc transform.TransformFunc) *QueryData {
+	column := &Column{Name: "id", Type: proto.ColumnType_INT, Transform: transform.From(transformFunc)}
+	d := &QueryData{
+		Table:           &Table{Name: "test", Columns: []*Column{column}},
+		Connection:      &Connection{Name: "c1"},
+		QueryContext:    &QueryContext{},
+		columns:         map[string]*QueryColumn{},
+		rowDataChan:     make(chan *rowData, rowDataBufferSize),
+		errorChan:       make(chan error, 100),
+		queryStatus:     newQueryStatus(nil),
+		reservedColumns: map[string]struct{}{},
+	}
+	if sorted {
+		d.QueryContext.SortOrder = []*SortColumn{{Column: "id", Order: SortAsc}}
+	}
+	queryColumn := NewQueryColumn(column, testRowBuilderHydrateName)
+	queryColumn.transforms = d.Table.getColumnTransforms(queryColumn)
+	d.columns[column.Name] = queryColumn
+	return d
+}
+
+// streamTestRowData streams rowData items for the values 0..count-1, followed by a nil item
+func streamTestRowData(d *QueryData, count int) {
+	for i := 0; i < count; i++ {
+		rd := newRowData(d, i)
+		rd.set(testRowBuilderHydrateName, i)
+		d.rowDataChan <- rd
+	}
+	d.rowDataChan <- nil
+}
+
+// buildTestRows builds rows for count rowData items using the row builder, returning the streamed ids
+func buildTestRows(d *QueryData, count int) []int64 {
+	rowChan := make(chan *proto.Row)
+	doneChan := make(chan bool)
+	d.buildRowsAsync(context.Background(), rowChan, doneChan)
+	go streamTestRowData(d, count)
+
+	var ids []int64
+	for row := range rowChan {
+		ids = append(ids, row.Columns["id"].GetIntValue())
+	}
+	return ids
+}
+
+func hydrateItemValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return d.HydrateItem, nil
+}
+
+func TestRowBuilderPreservesOrder(t *testing.T) {
+	// delay the earlier rows so they would complete after later rows
+	slowTransform := func(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+		time.Sleep(time.Duration(10-d.HydrateItem.(int)%10) * time.Millisecond)
+		return d.HydrateItem, nil
+	}
+	const count = 50
+	ids := buildTestRows(newTestRowBuilderQueryData(true, slowTransform), count)
+	if len(ids) != count {
+		t.Fatalf("expected %d rows, got %d", count, len(ids))
+	}
+	for i, id := range ids {
+		if id != int64(i) {
+			t.Fatalf("expected rows to be streamed in order, got %v", ids)
+		}
+	}
+}
+
+func TestRowBuilderUnordered(t *testing.T) {
+	const count = 500
+	ids := buildTestRows(newTestRowBuilderQueryData(false, hydrateItemValue), count)
+	if len(ids) != count {
+		t.Fatalf("expected %d rows, got %d", count, len(ids))
+	}
+	seen := make(map[int64]struct{})
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	if len(seen) != count {
+		t.Errorf("expected %d distinct rows, got %d", count, len(seen))
+	}
+}
+
+func TestRowBuilderMaxConcurrentRows(t *testing.T) {
+	t.Setenv("STEAMPIPE_MAX_CONCURRENT_ROWS", "4")
+	for _, sorted := range []bool{false, true} {
+		var current, max int32
+		countingTransform := func(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+			n := atomic.AddInt32(&current, 1)
+			defer atomic.AddInt32(&current, -1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return d.HydrateItem, nil
+		}
+		d := newTestRowBuilderQueryData(sorted, countingTransform)
+		if ids := buildTestRows(d, 100); len(ids) != 100 {
+			t.Errorf("sorted %v: expected 100 rows, got %d", sorted, len(ids))
+		}
+		if max > 4 {
+			t.Errorf("sorted %v: expected at most 4 concurrent rows, got %d", sorted, max)
+		}
+	}
+}
+
+func TestRowBuilderUnlimitedConcurrentRows(t *testing.T) {
+	// zero means the number of concurrent rows is unlimited
+	t.Setenv("STEAMPIPE_MAX_CONCURRENT_ROWS", "0")
+	for _, sorted := range []bool{false, true} {
+		d := newTestRowBuilderQueryData(sorted, hydrateItemValue)
+		if ids := buildTestRows(d, 100); len(ids) != 100 {
+			t.Errorf("sorted %v: expected 100 rows, got %d", sorted, len(ids))
+		}
+	}
+}
+
+func TestRowBuilderError(t *testing.T) {
+	for _, sorted := range []bool{false, true} {
+		failingTransform := func(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+			if d.HydrateItem.(int) == 3 {
+				return nil, errors.New("transform failed")
+			}
+			return d.HydrateItem, nil
+		}
+		d := newTestRowBuilderQueryData(sorted, failingTransform)
+		// the failed row is not streamed, but the row builder must still complete
+		if ids := buildTestRows(d, 10); len(ids) != 9 {
+			t.Errorf("sorted %v: expected 9 rows, got %d", sorted, len(ids))
+		}
+		select {
+		case err := <-d.errorChan:
+			if err == nil {
+				t.Errorf("sorted %v: expected an error", sorted)
+			}
+		default:
+			t.Errorf("sorted %v: expected the error to be streamed", sorted)
+		}
+	}
+}
+
+func TestRowBuilderCancelled(t *testing.T) {
+	d := newTestRowBuilderQueryData(false, hydrateItemValue)
+	rowChan := make(chan *proto.Row)
+	doneChan := make(chan bool)
+	b := newRowBuilder(d, rowChan, doneChan)
+	complete := make(chan struct{})
+	go func() {
+		b.run(context.Background())
+		close(complete)
+	}()
+	// stream rows but do not read the row channel, then signal done
+	for i := 0; i < 5; i++ {
+		rd := newRowData(d, i)
+		rd.set(testRowBuilderHydrateName, i)
+		d.rowDataChan <- rd
+	}
+	close(doneChan)
+	select {
+	case <-complete:
+	case <-time.After(5 * time.Second):
+		t.Fatal("row builder did not exit after done was signalled")
+	}
+}
+
+func TestRowBuilderAcquireFailed(t *testing.T) {
+	t.Setenv("STEAMPIPE_MAX_CONCURRENT_ROWS", "1")
+	d := newTestRowBuilderQueryData(false, hydrateItemValue)
+	doneChan := make(chan bool)
+	b := newRowBuilder(d, make(chan *proto.Row), doneChan)
+	if !b.acquire(context.Background()) {
+		t.Fatal("expected the first acquire to succeed")
+	}
+	// the maximum number of rows are in flight - a cancelled acquire must not count as a row in flight
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if b.acquire(ctx) {
+		t.Fatal("expected acquire to fail when the context is cancelled")
+	}
+	close(doneChan)
+	if b.acquire(context.Background()) {
+		t.Fatal("expected acquire to fail when done is signalled")
+	}
+	if n := b.rowsInFlight.Load(); n != 1 {
+		t.Errorf("expected 1 row in flight, got %d", n)
+	}
+	b.release()
+	if n := b.rowsInFlight.Load(); n != 0 {
+		t.Errorf("expected 0 rows in flight, got %d", n)
+	}
+}
+
+func TestRowDataRelease(t *testing.T) {
+	d := newTestRowBuilderQueryData(false, hydrateItemValue)
+	rd := newRowData(d, "item")
+	hydrateResults := rd.hydrateResults
+	rd.set(testRowBuilderHydrateName, "item")
+	rd.setError("failedHydrate", errors.New("failed"))
+	rd.release()
+
+	rd = newRowData(d, "item2")
+	if len(rd.hydrateErrors) != 0 {
+		t.Errorf("expected hydrate errors to be cleared, got %v", rd.hydrateErrors)
+	}
+	if len(rd.hydrateResults) != 0 {
+		t.Errorf("expected empty hydrate results, got %v", rd.hydrateResults)
+	}
+	// the hydrate results of the released rowData must not be modified, as these may have been retained by a hydrate function
+	if hydrateResults[testRowBuilderHydrateName] != "item" {
+		t.Errorf("expected the hydrate results of the released rowData to be unchanged")
+	}
+}
+
+// legacyBuildRowsAsync is the previous row builder implementation, which started a goroutine per row,
+// gated by a semaphore, and chained wait groups to preserve row ordering
+// - used to benchmark the row builder
+func legacyBuildRowsAsync(ctx context.Context, d *QueryData, rowChan chan *proto.Row, maxConcurrentRows int) {
+	var rowWg sync.WaitGroup
+	rowSemaphore := make(chan struct{}, maxConcurrentRows)
+	go func() {
+		var prevRowOrderingWg *sync.WaitGroup
+		for rd := range d.rowDataChan {
+			if rd == nil {
+				rowWg.Wait()
+				close(rowChan)
+				return
+			}
+			rowSemaphore <- struct{}{}
+			rowWg.Add(1)
+			orderingWg := &sync.WaitGroup{}
+			orderingWg.Add(1)
+			go func(rd *rowData, prevRowWg *sync.WaitGroup) {
+				defer func() {
+					rowWg.Done()
+					<-rowSemaphore
+				}()
+				rows, err := rd.getRows(ctx)
+				if err != nil {
+					d.streamError(err)
+					return
+				}
+				for _, row := range rows {
+					d.removeReservedColumns(row)
+					d.addContextData(row, rd)
+				}
+				if len(d.QueryContext.SortOrder) > 0 && prevRowWg != nil {
+					prevRowWg.Wait()
+				}
+				for _, row := range rows {
+					rowChan <- row
+				}
+				orderingWg.Done()
+			}(rd, prevRowOrderingWg)
+			prevRowOrderingWg = orderingWg
+		}
+	}()
+}
+
+const benchmarkRowCount = 10000
+
+func benchmarkRowBuilder(b *testing.B, sorted bool, legacy bool) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d := newTestRowBuilderQueryData(sorted, hydrateItemValue)
+		rowChan := make(chan *proto.Row)
+		if legacy {
+			legacyBuildRowsAsync(context.Background(), d, rowChan, 500)
+		} else {
+			d.buildRowsAsync(context.Background(), rowChan, make(chan bool))
+		}
+		go streamTestRowData(d, benchmarkRowCount)
+		rows := 0
+		for range rowChan {
+			rows++
+		}
+		if rows != benchmarkRowCount {
+			b.Fatalf("expected %d rows, got %d", benchmarkRowCount, rows)
+		}
+	}
+	b.ReportMetric(float64(b.N*benchmarkRowCount)/b.Elapsed().Seconds(), "rows/s")
+}
+
+func BenchmarkRowBuilder(b *testing.B) {
+	benchmarkRowBuilder(b, false, false)
+}
+
+func BenchmarkRowBuilderLegacy(b *testing.B) {
+	benchmarkRowBuilder(b, false, true)
+}
+
+func BenchmarkRowBuilderSorted(b *testing.B) {
+	benchmarkRowBuilder(b, true, false)
+}
+
+func BenchmarkRowBuilderSortedLegacy(b *testing.B) {
+	benchmarkRowBuilder(b, true, true)
+}
+
+// ensure the test helpers build a valid row
+func TestRowBuilderRowContent(t *testing.T) {
+	d := newTestRowBuilderQueryData(false, hydrateItemValue)
+	rowChan := make(chan *proto.Row)
+	d.buildRowsAsync(context.Background(), rowChan, make(chan bool))
+	go streamTestRowData(d, 1)
+	row := <-rowChan
+	if row == nil || row.Columns[contextColumnName] == nil {
+		t.Fatalf("expected row with context column, got %v", row)
+	}
+	if _, ok := <-rowChan; ok {
+		t.Errorf("expected row channel to be closed")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/logging"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"github.com/turbot/steampipe-plugin-sdk/v5/telemetry"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
@@ -21,7 +22,9 @@ type rowData struct {
 	// the output of the get/list call which is passed to all other hydrate calls
 	item interface{}
 	// if there was a parent-child list call, store the parent list item
-	parentItem      interface{}
+	parentItem interface{}
+	// if there was a chain of parent list calls, store the item for each level
+	parentItems     []interface{}
 	matrixItem      map[string]interface{}
 	hydrateResults  map[string]interface{}
 	hydrateErrors   map[string]error
@@ -35,30 +38,63 @@ type rowData struct {
 
 	delayMapMut             sync.RWMutex
 	hydrateConcurrencyDelay map[string]*hydrateConcurrencyDelay
+}
 
-	// wait group to ensure correct row ordering
-	orderingWg sync.WaitGroup
+// pool of rowData objects - rowData objects are returned to the pool once their rows have been built
+// NOTE: the proto.Row objects built from the rowData are not pooled, as their lifetime is not controlled by the row builder -
+// a row may be retained by the query cache, and is owned by the GRPC stream until it has been sent
+var rowDataPool = sync.Pool{
+	New: func() any {
+		return &rowData{
+			hydrateErrors:           make(map[string]error),
+			hydrateConcurrencyDelay: make(map[string]*hydrateConcurrencyDelay),
+		}
+	},
 }
 
 // newRowData creates an empty rowData object
 func newRowData(d *QueryData, item interface{}) *rowData {
+	r := rowDataPool.Get().(*rowData)
+
 	// create buffered error channel for any errors occurring hydrate functions (+2 is for the get and list hydrate calls)
-	errorChan := make(chan error, len(d.hydrateCalls)+2)
-
-	return &rowData{
-		item:                    item,
-		matrixItem:              make(map[string]interface{}),
-		hydrateResults:          make(map[string]interface{}),
-		hydrateErrors:           make(map[string]error),
-		waitChan:                make(chan bool),
-		table:                   d.Table,
-		errorChan:               errorChan,
-		queryData:               d,
-		hydrateConcurrencyDelay: make(map[string]*hydrateConcurrencyDelay),
+	// (reuse the error channel of a pooled rowData if it is the correct size - it is always empty)
+	if errorChanSize := len(d.hydrateCalls) + 2; r.errorChan == nil || cap(r.errorChan) != errorChanSize {
+		r.errorChan = make(chan error, errorChanSize)
+	}
+	r.item = item
+	r.matrixItem = make(map[string]interface{})
+	// NOTE: always create a new hydrate results map as this is passed to hydrate functions
+	r.hydrateResults = make(map[string]interface{})
+	r.waitChan = make(chan bool)
+	r.table = d.Table
+	r.queryData = d
+	return r
+}
+
+// release returns the rowData to the pool
+// this must only be called once all hydrate calls are complete and the rows have been built
+func (r *rowData) release() {
+	// if the error channel is not empty, do not reuse it
+	if len(r.errorChan) > 0 {
+		r.errorChan = nil
 	}
+	clear(r.hydrateErrors)
+	clear(r.hydrateConcurrencyDelay)
+	r.item = nil
+	r.parentItem = nil
+	r.parentItems = nil
+	r.matrixItem = nil
+	r.hydrateResults = nil
+	r.hydrateMetadata = nil
+	r.waitChan = nil
+	r.table = nil
+	r.queryData = nil
+	rowDataPool.Put(r)
 }
 
-func (r *rowData) getRow(ctx context.Context) (*proto.Row, error) {
+// getRows returns the row for this rowData
+// - if an explode hydrate call is required, a row is returned for each child item
+func (r *rowData) getRows(ctx context.Context) ([]*proto.Row, error) {
 	// NOTE: the rowData (may) have matrixItem set
 	// (this is a data structure containing fetch specific data, e.g. region)
 	// store this in the context for use by the transform functions
@@ -100,6 +136,7 @@ func (r *rowData) startAllHydrateCalls(rowDataCtx context.Context, rowQueryData
 					RateLimiters: call.rateLimiter.LimiterNames(),
 					DelayMs:      rateLimitDelay.Milliseconds(),
 				})
+				rowQueryData.getHydrateStats(hydrateFuncName).onRateLimit(rateLimitDelay, call.rateLimiter.LimiterNames())
 
 				callsStarted[hydrateFuncName] = true
 			} else {
@@ -124,8 +161,8 @@ func (r *rowData) startAllHydrateCalls(rowDataCtx context.Context, rowQueryData
 }
 
 // wait for all hydrate calls to complete
-func (r *rowData) waitForHydrateCallsToComplete(rowDataCtx context.Context) (*proto.Row, error) {
-	var row *proto.Row
+func (r *rowData) waitForHydrateCallsToComplete(rowDataCtx context.Context) ([]*proto.Row, error) {
+	var rows []*proto.Row
 
 	// start a go routine which signals via the wait chan when all calls are complete
 	// (we need this slightly convoluted mechanism to allow us to check for upstream errors
@@ -136,7 +173,13 @@ func (r *rowData) waitForHydrateCallsToComplete(rowDataCtx context.Context) (*pr
 		var err error
 
 		// now execute any transforms required to populate the column values
-		row, err = r.getColumnValues(rowDataCtx)
+		if explodeCall := r.queryData.getExplodeCall(); explodeCall != nil {
+			rows, err = r.getExplodedColumnValues(rowDataCtx, explodeCall.Name)
+		} else {
+			var row *proto.Row
+			row, err = r.getColumnValues(rowDataCtx)
+			rows = []*proto.Row{row}
+		}
 		if err != nil {
 			r.queryData.streamError(err)
 		}
@@ -149,21 +192,31 @@ func (r *rowData) waitForHydrateCallsToComplete(rowDataCtx context.Context) (*pr
 		log.Printf("[WARN] hydrate error chan select: %s (%s)", r.queryData.connectionCallId, err)
 		return nil, err
 	case <-r.waitChan:
-		return row, nil
+		return rows, nil
 	}
 }
 
 // generate the column values for all requested columns
 func (r *rowData) getColumnValues(ctx context.Context) (*proto.Row, error) {
-	row := &proto.Row{Columns: make(map[string]*proto.Column)}
+	// NOTE: size the columns map to include the reserved and context columns
+	row := &proto.Row{Columns: make(map[string]*proto.Column, len(r.queryData.columns)+3)}
 
+	// the key used to hash columns with the hash redaction policy (if any)
+	hashKey := getConnectionRedaction(r.queryData).getHashKey()
+	// cache the json representation of the hydrate items for any expression transforms
+	jsonValues := transform.NewJSONValueCache()
 	// queryData.columns contains all columns returned by the hydrate calls which have been executed
 	for _, column := range r.queryData.columns {
-		val, err := r.table.getColumnValue(ctx, r, column)
+		// dropped columns are not included in the connection schema
+		if column.redaction == redactionDrop {
+			continue
+		}
+		val, err := r.table.getColumnValue(ctx, r, column, jsonValues)
 		if err != nil {
 			return nil, err
 		}
-		row.Columns[column.Name] = val
+		// apply any connection redaction policy before the row is streamed or cached
+		row.Columns[column.Name] = redactColumnValue(column.redaction, val, hashKey)
 	}
 
 	return row, nil
@@ -213,16 +266,26 @@ func (r *rowData) callHydrateWithRetries(ctx context.Context, d *QueryData, hydr
 		span.End()
 	}()
 
-	h := &HydrateData{Item: r.item, ParentItem: r.parentItem, HydrateResults: r.hydrateResults}
+	// record the call duration (including retries) in the query stats
+	start := time.Now()
+	defer func() {
+		d.getHydrateStats(hydrate.Name).onCall(d.getHydrateCallType(hydrate.Name), time.Since(start))
+	}()
+
+	h := r.hydrateData()
 	// WrapHydrate function returns a HydrateFunc which handles Ignorable errors
 	var hydrateWithIgnoreError = WrapHydrate(hydrate, ignoreConfig)
 	hydrateResult, err = hydrateWithIgnoreError.Func(ctx, d, h)
 	if err != nil {
 		log.Printf("[TRACE] hydrateWithIgnoreError returned error %v", err)
 
-		if shouldRetryError(ctx, d, h, err, retryConfig) {
+		if shouldRetryError(ctx, d, h, hydrate.Name, err, retryConfig) {
+			// if the error carries a server-provided retry delay, wait before retrying
+			if waitErr := waitForRetryAfter(ctx, err); waitErr != nil {
+				return nil, waitErr
+			}
 			log.Printf("[TRACE] retrying hydrate")
-			hydrateData := &HydrateData{Item: r.item, ParentItem: r.parentItem, HydrateResults: r.hydrateResults}
+			hydrateData := r.hydrateData()
 			hydrateResult, err = retryNamedHydrate(ctx, d, hydrateData, hydrate, retryConfig)
 			log.Printf("[TRACE] back from retry")
 		}
@@ -231,6 +294,11 @@ func (r *rowData) callHydrateWithRetries(ctx context.Context, d *QueryData, hydr
 	return hydrateResult, err
 }
 
+// hydrateData returns the HydrateData passed to hydrate functions for this row
+func (r *rowData) hydrateData() *HydrateData {
+	return &HydrateData{Item: r.item, ParentItem: r.parentItem, ParentItems: r.parentItems, HydrateResults: r.hydrateResults}
+}
+
 func (r *rowData) set(key string, item interface{}) error {
 	// acquire a Write lock
 	r.mut.Lock()
@@ -1,6 +1,10 @@
 package plugin
 
-import "github.com/turbot/steampipe-plugin-sdk/v5/version"
+import (
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/version"
+)
 
 type hydrateMetadata struct {
 	Type         string            `json:"type"`
@@ -10,6 +14,13 @@ type hydrateMetadata struct {
 	DelayMs      int64             `json:"rate_limiter_delay_ms"`
 }
 
+// withDelay returns a copy of the metadata with the given rate limiter delay
+func (m *hydrateMetadata) withDelay(delay time.Duration) *hydrateMetadata {
+	res := *m
+	res.DelayMs = delay.Milliseconds()
+	return &res
+}
+
 type SteampipeMetadata struct {
 	SdkVersion string `json:"sdk_version"`
 }
@@ -17,6 +28,10 @@ type SteampipeMetadata struct {
 type rowCtxData struct {
 	Connection string            `json:"connection_name"`
 	Steampipe  SteampipeMetadata `json:"steampipe"`
+	// the matrix item which the row was fetched for
+	MatrixItem map[string]interface{} `json:"matrix_item,omitempty"`
+	// the matrix items which have failed (so far)
+	FailedMatrixItems []map[string]interface{} `json:"failed_matrix_items,omitempty"`
 
 	Diagnostics *rowCtxDiagnostics `json:"diagnostics,omitempty"`
 }
@@ -32,6 +47,12 @@ func newRowCtxData(rd *rowData) *rowCtxData {
 			SdkVersion: version.String(),
 		},
 	}
+	if len(rd.matrixItem) > 0 {
+		res.MatrixItem = rd.matrixItem
+	}
+	if d.queryStatus != nil {
+		res.FailedMatrixItems = d.queryStatus.matrixItems.failed()
+	}
 
 	if loadDiagnosticsEnvVar() == DiagnosticsAll {
 		calls := append([]*hydrateMetadata{d.fetchMetadata}, rd.hydrateMetadata...)
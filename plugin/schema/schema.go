@@ -2,11 +2,47 @@ package schema
 
 import (
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hcldec"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/gocty"
 )
 
+/*
+Attribute defines a connection config attribute (or nested block).
+
+For example:
+
+	var ConfigSchema = map[string]*schema.Attribute{
+		"regions": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Attribute{Type: schema.TypeString},
+			Default:     []string{"*"},
+			Description: "The regions to query.",
+		},
+		"tags": {
+			Type: schema.TypeMap,
+			Elem: &schema.Attribute{Type: schema.TypeString},
+		},
+		"log_level": {
+			Type:          schema.TypeString,
+			AllowedValues: []string{"debug", "info", "warn"},
+			Default:       "info",
+		},
+		"assume_role": {
+			Type: schema.TypeBlock,
+			Attributes: map[string]*schema.Attribute{
+				"role_arn":    {Type: schema.TypeString, Required: true},
+				"external_id": {Type: schema.TypeString},
+			},
+		},
+	}
+*/
 type Attribute struct {
 	// name - this will be inferred by the schema map key
 	Name string
@@ -16,13 +52,62 @@ type Attribute struct {
 	//   TypeFloat - float64
 	//   TypeString - string
 	//   TypeList - []interface{}
+	//   TypeMap - map[string]interface{}
+	//   TypeObject - struct (an object value, e.g. role = { arn = "..." })
+	//   TypeBlock - struct (a nested block, e.g. assume_role { arn = "..." })
 	Type ValueType
 
-	// Elem represents the element type. This may only be set for only set for TypeList.
+	// Elem represents the element type. This may only be set for TypeList and TypeMap.
+	// A TypeList with an Elem of TypeBlock is a repeated nested block.
 	Elem *Attribute
 
+	// Attributes are the nested attributes. This may only be set for TypeObject and TypeBlock.
+	Attributes map[string]*Attribute
+
 	// is this attribute required
 	Required bool
+
+	// the value used if the attribute is not set (optional attributes only)
+	Default any
+
+	// if set, the environment variable used if the attribute is not set (before falling back to Default).
+	// This may only be set for TypeBool, TypeInt, TypeFloat and TypeString.
+	// A required attribute with an EnvVar may be omitted from the config if the environment variable is set.
+	EnvVar string
+
+	// if set, the allowed values of a TypeString attribute
+	AllowedValues []string
+
+	// the attribute description
+	Description string
+}
+
+// DefaultValue returns the Default as a cty value of the attribute type
+func (a *Attribute) DefaultValue() (cty.Value, error) {
+	ctyType := attributeTypeToCty(a)
+	if a.Default == nil {
+		return cty.NullVal(ctyType), nil
+	}
+	return gocty.ToCtyValue(a.Default, ctyType)
+}
+
+// envVarValue converts the value of the EnvVar environment variable to the attribute type
+// NOTE: the value is not included in any error as it may be a secret
+func (a *Attribute) envVarValue(envValue string) (cty.Value, error) {
+	res, err := convert.Convert(cty.StringVal(envValue), attributeTypeToCty(a))
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("the value of the %s environment variable is not a valid %s", a.EnvVar, a.Type)
+	}
+	if len(a.AllowedValues) > 0 && !slices.Contains(a.AllowedValues, envValue) {
+		return cty.NilVal, fmt.Errorf("the value of the %s environment variable must be one of: %s", a.EnvVar, strings.Join(a.AllowedValues, ", "))
+	}
+	return res, nil
+}
+
+// IsBlock returns whether the attribute is decoded from nested blocks,
+// i.e. is TypeBlock, or a TypeList of TypeBlock
+func (a *Attribute) IsBlock() bool {
+	return a.Type == TypeBlock || (a.Type == TypeList && a.Elem != nil && a.Elem.Type == TypeBlock)
 }
 
 func SchemaToObjectSpec(schema map[string]*Attribute) hcldec.ObjectSpec {
@@ -36,12 +121,43 @@ func SchemaToObjectSpec(schema map[string]*Attribute) hcldec.ObjectSpec {
 }
 
 func attributeToSpec(attr *Attribute) hcldec.Spec {
-	return &hcldec.AttrSpec{
-		Name: attr.Name,
-		Type: attributeTypeToCty(attr),
-		// we have validated that Required = !Optional so here we can safely only consider Required
-		Required: attr.Required,
+	var spec hcldec.Spec
+	switch {
+	case attr.Type == TypeBlock:
+		spec = &hcldec.BlockSpec{
+			TypeName: attr.Name,
+			Nested:   SchemaToObjectSpec(attr.Attributes),
+			Required: attr.Required,
+		}
+	case attr.IsBlock():
+		listSpec := &hcldec.BlockListSpec{
+			TypeName: attr.Name,
+			Nested:   SchemaToObjectSpec(attr.Elem.Attributes),
+		}
+		if attr.Required {
+			listSpec.MinItems = 1
+		}
+		spec = listSpec
+	default:
+		spec = &hcldec.AttrSpec{
+			Name: attr.Name,
+			Type: attributeTypeToCty(attr),
+			// we have validated that Required = !Optional so here we can safely only consider Required
+			// (if the attribute has an EnvVar, ApplyDefaults verifies a value has been set)
+			Required: attr.Required && attr.EnvVar == "",
+		}
 	}
+
+	// if the attribute (or any nested attribute) has allowed values, validate the decoded value
+	if hasAllowedValues(attr) {
+		spec = &hcldec.ValidateSpec{
+			Wrapped: spec,
+			Func: func(value cty.Value) hcl.Diagnostics {
+				return validateAllowedValues(attr, attr.Name, value)
+			},
+		}
+	}
+	return spec
 }
 
 func attributeTypeToCty(attr *Attribute) cty.Type {
@@ -52,11 +168,29 @@ func attributeTypeToCty(attr *Attribute) cty.Type {
 		return cty.Bool
 	case TypeFloat, TypeInt:
 		return cty.Number
-	case TypeList:
+	case TypeList, TypeMap:
 		if attr.Elem == nil {
-			panic(fmt.Sprintf("attribute %s is TypeList but 'Elem' is not set", attr.Name))
+			panic(fmt.Sprintf("attribute %s is %s but 'Elem' is not set", attr.Name, attr.Type))
+		}
+		if attr.Type == TypeMap {
+			return cty.Map(attributeTypeToCty(attr.Elem))
 		}
 		return cty.List(attributeTypeToCty(attr.Elem))
+	case TypeObject, TypeBlock:
+		if len(attr.Attributes) == 0 {
+			panic(fmt.Sprintf("attribute %s is %s but 'Attributes' is not set", attr.Name, attr.Type))
+		}
+		var optional []string
+		for name, nested := range attr.Attributes {
+			if !nested.Required || nested.EnvVar != "" {
+				optional = append(optional, name)
+			}
+		}
+		// blocks are decoded using an ObjectSpec, so all attributes are present
+		if attr.Type == TypeBlock {
+			return cty.Object(attributeTypeMapToCty(attr.Attributes))
+		}
+		return cty.ObjectWithOptionalAttrs(attributeTypeMapToCty(attr.Attributes), optional)
 	default:
 		panic(fmt.Sprintf("invalid attribute type %v", attr.Type))
 	}
@@ -69,3 +203,137 @@ func attributeTypeMapToCty(attrTypes map[string]*Attribute) map[string]cty.Type
 	}
 	return res
 }
+
+func hasAllowedValues(attr *Attribute) bool {
+	if attr == nil {
+		return false
+	}
+	if len(attr.AllowedValues) > 0 {
+		return true
+	}
+	// nested blocks are validated by their own specs
+	if attr.Type == TypeBlock {
+		return false
+	}
+	if hasAllowedValues(attr.Elem) && attr.Elem.Type != TypeBlock {
+		return true
+	}
+	if attr.Type == TypeObject {
+		for _, nested := range attr.Attributes {
+			if hasAllowedValues(nested) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateAllowedValues checks the value (and any nested values) against the attribute AllowedValues
+func validateAllowedValues(attr *Attribute, path string, value cty.Value) hcl.Diagnostics {
+	if value.IsNull() || !value.IsWhollyKnown() {
+		return nil
+	}
+	var diags hcl.Diagnostics
+	switch attr.Type {
+	case TypeString:
+		if len(attr.AllowedValues) > 0 && !slices.Contains(attr.AllowedValues, value.AsString()) {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Invalid value",
+				// do not include the value - it may have been resolved from a secret, environment variable or file
+				Detail: fmt.Sprintf("invalid value for '%s' - must be one of: %s", path, strings.Join(attr.AllowedValues, ", ")),
+			})
+		}
+	case TypeList, TypeMap:
+		for it := value.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+			var elemPath string
+			if key.Type() == cty.String {
+				elemPath = fmt.Sprintf("%s[\"%s\"]", path, key.AsString())
+			} else {
+				i, _ := key.AsBigFloat().Int64()
+				elemPath = fmt.Sprintf("%s[%d]", path, i)
+			}
+			diags = append(diags, validateAllowedValues(attr.Elem, elemPath, elem)...)
+		}
+	case TypeObject:
+		for name, nested := range attr.Attributes {
+			diags = append(diags, validateAllowedValues(nested, path+"."+name, value.GetAttr(name))...)
+		}
+	}
+	return diags
+}
+
+// ApplyDefaults returns the decoded connection config value, with the EnvVar or Default of any attributes
+// which are not set applied (including the attributes of objects and nested blocks)
+func ApplyDefaults(schema map[string]*Attribute, value cty.Value) (cty.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+	values := value.AsValueMap()
+	if values == nil {
+		values = make(map[string]cty.Value, len(schema))
+	}
+	for name, attr := range schema {
+		attrValue, ok := values[name]
+		if !ok {
+			// only optional object attributes may be missing
+			continue
+		}
+		res, err := applyAttributeDefaults(attr, attrValue)
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("attribute '%s': %s", name, err.Error())
+		}
+		values[name] = res
+	}
+	return cty.ObjectVal(values), nil
+}
+
+func applyAttributeDefaults(attr *Attribute, value cty.Value) (cty.Value, error) {
+	if value.IsNull() {
+		if attr.EnvVar != "" {
+			if envValue := os.Getenv(attr.EnvVar); envValue != "" {
+				return attr.envVarValue(envValue)
+			}
+		}
+		if attr.Default != nil {
+			return attr.DefaultValue()
+		}
+		if attr.Required && attr.EnvVar != "" {
+			return cty.NilVal, fmt.Errorf("a value is required - set the attribute or the %s environment variable", attr.EnvVar)
+		}
+		return value, nil
+	}
+	if !value.IsWhollyKnown() {
+		return value, nil
+	}
+	switch attr.Type {
+	case TypeObject, TypeBlock:
+		return ApplyDefaults(attr.Attributes, value)
+	case TypeList, TypeMap:
+		if attr.Elem.Type != TypeObject && attr.Elem.Type != TypeBlock || value.LengthInt() == 0 {
+			return value, nil
+		}
+		if attr.Type == TypeMap {
+			elems := value.AsValueMap()
+			for k, elem := range elems {
+				res, err := applyAttributeDefaults(attr.Elem, elem)
+				if err != nil {
+					return cty.NilVal, err
+				}
+				elems[k] = res
+			}
+			return cty.MapVal(elems), nil
+		}
+		elems := value.AsValueSlice()
+		for i, elem := range elems {
+			res, err := applyAttributeDefaults(attr.Elem, elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			elems[i] = res
+		}
+		return cty.ListVal(elems), nil
+	}
+	return value, nil
+}
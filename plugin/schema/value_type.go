@@ -1,5 +1,7 @@
 package schema
 
+import "fmt"
+
 // ValueType is an enum of the type that can be represented by a schema.
 type ValueType int
 
@@ -10,4 +12,29 @@ const (
 	TypeFloat
 	TypeString
 	TypeList
+	TypeMap
+	TypeObject
+	TypeBlock
 )
+
+func (t ValueType) String() string {
+	switch t {
+	case TypeBool:
+		return "TypeBool"
+	case TypeInt:
+		return "TypeInt"
+	case TypeFloat:
+		return "TypeFloat"
+	case TypeString:
+		return "TypeString"
+	case TypeList:
+		return "TypeList"
+	case TypeMap:
+		return "TypeMap"
+	case TypeObject:
+		return "TypeObject"
+	case TypeBlock:
+		return "TypeBlock"
+	}
+	return fmt.Sprintf("TypeInvalid(%d)", int(t))
+}
@@ -101,6 +101,8 @@ func Server(opts *ServeOpts) *grpc.PluginServer {
 		p.getRateLimiters,
 		p.setConnectionCacheOptions,
 		p.getSchemaMode,
+		p.getConnectionConfigSchema,
+		p.testConnections,
 	)
 }
 
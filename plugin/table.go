@@ -2,6 +2,7 @@ package plugin
 
 import (
 	"log"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
@@ -53,6 +54,12 @@ type Table struct {
 	// function controlling default error handling behaviour
 	DefaultIgnoreConfig *IgnoreConfig
 	DefaultRetryConfig  *RetryConfig
+	// default timeout for each hydrate, get and list call - defaults to the plugin DefaultTimeout
+	DefaultTimeout time.Duration
+	// timeout for a query of this table - defaults to the plugin DefaultQueryTimeout
+	QueryTimeout time.Duration
+	// the failure policy and concurrency used when fetching matrix items - defaults to the plugin DefaultMatrixConfig
+	MatrixConfig *MatrixConfig
 	// the parent plugin object
 	Plugin *Plugin
 	// Deprecated: used HydrateConfig
@@ -62,6 +69,12 @@ type Table struct {
 	HydrateConfig []HydrateConfig
 	// cache options - allows disabling of cache for this table
 	Cache *TableCacheOptions
+	// is the table deprecated
+	Deprecated bool
+	// the name of the table which replaces this (deprecated) table
+	ReplacedBy string
+	// the plugin version in which this (deprecated) table will be removed
+	RemovalVersion string
 
 	// tags used to provide scope values for all child hydrate calls
 	// (may be used for more in future)
@@ -113,6 +126,21 @@ func (t *Table) initialise(p *Plugin) {
 	log.Printf("[TRACE] apply plugin defaults for DefaultIgnoreConfig, table %v plugin %v", t.DefaultIgnoreConfig, t.Plugin.DefaultIgnoreConfig)
 	t.DefaultIgnoreConfig.DefaultTo(t.Plugin.DefaultIgnoreConfig)
 
+	// apply plugin defaults for timeouts
+	if t.DefaultTimeout == 0 {
+		t.DefaultTimeout = t.Plugin.DefaultTimeout
+	}
+	if t.QueryTimeout == 0 {
+		t.QueryTimeout = t.Plugin.DefaultQueryTimeout
+	}
+
+	// create MatrixConfig if needed and apply plugin defaults
+	if t.MatrixConfig == nil {
+		t.MatrixConfig = &MatrixConfig{}
+	}
+	t.MatrixConfig.DefaultTo(t.Plugin.DefaultMatrixConfig)
+	t.MatrixConfig.initialise()
+
 	log.Printf("[TRACE] DefaultRetryConfig: %s", t.DefaultRetryConfig.String())
 	log.Printf("[TRACE] DefaultIgnoreConfig: %s", t.DefaultIgnoreConfig.String())
 
@@ -126,6 +154,8 @@ func (t *Table) initialise(p *Plugin) {
 		log.Printf("[TRACE] t.List.initialise")
 		t.List.initialise(t)
 	}
+	// resolve deprecated column aliases - this must be done before the columns are initialised
+	t.initialiseColumnAliases()
 	// initialise columns
 	for _, c := range t.Columns {
 		c.initialise()
@@ -207,13 +237,15 @@ func (t *Table) hydrateConfigFromGet(get *GetConfig) *HydrateConfig {
 		return nil
 	}
 	c := &HydrateConfig{
-		namedHydrate:      get.namedHydrate,
-		Func:              get.Hydrate,
-		IgnoreConfig:      get.IgnoreConfig,
-		RetryConfig:       get.RetryConfig,
-		Tags:              get.Tags,
-		ShouldIgnoreError: get.ShouldIgnoreError,
-		MaxConcurrency:    get.MaxConcurrency,
+		namedHydrate:         get.namedHydrate,
+		Func:                 get.Hydrate,
+		IgnoreConfig:         get.IgnoreConfig,
+		RetryConfig:          get.RetryConfig,
+		CircuitBreakerConfig: get.CircuitBreakerConfig,
+		Timeout:              get.Timeout,
+		Tags:                 get.Tags,
+		ShouldIgnoreError:    get.ShouldIgnoreError,
+		MaxConcurrency:       get.MaxConcurrency,
 	}
 	// be sure to initialise the config
 	c.initialise(t)
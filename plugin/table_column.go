@@ -28,7 +28,7 @@ func (t *Table) getColumnType(columnName string) proto.ColumnType {
 }
 
 // take the raw value returned by the get/list/hydrate call, apply transforms and convert to protobuf value
-func (t *Table) getColumnValue(ctx context.Context, rowData *rowData, column *QueryColumn) (*proto.Column, error) {
+func (t *Table) getColumnValue(ctx context.Context, rowData *rowData, column *QueryColumn, jsonValues *transform.JSONValueCache) (*proto.Column, error) {
 	hydrateItem, err := rowData.GetColumnData(column)
 	if err != nil {
 		log.Printf("[ERROR] table '%s' failed to get column data: %s (%s)", t.Name, err.Error(), rowData.queryData.connectionCallId)
@@ -38,16 +38,20 @@ func (t *Table) getColumnValue(ctx context.Context, rowData *rowData, column *Qu
 	var value interface{} = nil
 	// only call transforms if the hydrate item is non nil
 	if !helpers.IsNil(hydrateItem) {
-		// are there any generate transforms defined? if not apply default generate
-		// NOTE: we must call getColumnTransforms to ensure the default is used if none is defined
-		columnTransforms := t.getColumnTransforms(column)
+		// use the transforms resolved when the query columns were built
+		// if these have not been resolved, call getColumnTransforms to ensure the default is used if none is defined
+		columnTransforms := column.transforms
+		if columnTransforms == nil {
+			columnTransforms = t.getColumnTransforms(column)
+		}
 
 		qualValueMap := rowData.queryData.Quals.ToQualMap()
 		transformData := &transform.TransformData{
 			HydrateItem:    hydrateItem,
 			HydrateResults: rowData.hydrateResults,
-			ColumnName:     column.Name,
+			ColumnName:     column.valueColumn().Name,
 			KeyColumnQuals: qualValueMap,
+			JSONValues:     jsonValues,
 		}
 		value, err = columnTransforms.Execute(ctx, transformData)
 		if err != nil {
@@ -64,6 +68,10 @@ func (t *Table) getColumnValue(ctx context.Context, rowData *rowData, column *Qu
 // if there are any column transforms defined return them
 // otherwise return either the table default (if it exists) or the base default Transform function
 func (t *Table) getColumnTransforms(column *QueryColumn) *transform.ColumnTransforms {
+	// a deprecated column alias uses the transforms of its replacement column
+	if column.aliasOf != nil {
+		column = NewQueryColumn(column.aliasOf, column.hydrateName)
+	}
 	columnTransform := column.Transform
 	if columnTransform == nil {
 		columnTransform = t.getDefaultColumnTransform(column)
@@ -0,0 +1,463 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+	"golang.org/x/exp/maps"
+)
+
+/*
+Table reference docs are generated from the [Table] and [Column] definitions, so they cannot drift from the plugin code.
+
+[GetTableDocs] builds a [TableDoc] for each table of the plugin (using the TableMapFunc for a sample connection config, if needed),
+which may be written as markdown or JSON using [WriteTableDocs].
+
+[CheckTableDocs] compares the generated docs with the committed docs, and fails if they are stale.
+This may be used in a test, so the build fails if the docs have not been regenerated:
+
+	func TestTableDocs(t *testing.T) {
+		docs, err := plugin.GetTableDocs(context.Background(), aws.Plugin, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if os.Getenv("UPDATE_DOCS") != "" {
+			err = plugin.WriteTableDocs(docs, "../docs/tables", plugin.TableDocFormatMarkdown)
+		} else {
+			err = plugin.CheckTableDocs(docs, "../docs/tables", plugin.TableDocFormatMarkdown)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+*/
+
+// TableDocFormat is the file format of generated table docs
+type TableDocFormat string
+
+const (
+	TableDocFormatMarkdown TableDocFormat = "markdown"
+	TableDocFormatJSON     TableDocFormat = "json"
+)
+
+func (f TableDocFormat) extension() string {
+	if f == TableDocFormatJSON {
+		return ".json"
+	}
+	return ".md"
+}
+
+// hydrate cost hints for a column
+const (
+	// the column is populated by a hydrate call for every row
+	hydrateCostPerRow = "per_row"
+	// the column is populated by a batched hydrate call
+	hydrateCostBatched = "batched"
+)
+
+// the header added to generated markdown docs
+const tableDocHeader = "<!-- generated from the table definition - do not edit -->"
+
+// TableDoc is the reference documentation for a table
+type TableDoc struct {
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	Deprecated     bool              `json:"deprecated,omitempty"`
+	ReplacedBy     string            `json:"replaced_by,omitempty"`
+	RemovalVersion string            `json:"removal_version,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	// the list and get calls - nil if the table does not support the operation
+	List    *TableDocFetch     `json:"list,omitempty"`
+	Get     *TableDocFetch     `json:"get,omitempty"`
+	Columns []*TableDocColumn  `json:"columns"`
+	Hydrate []*TableDocHydrate `json:"hydrate,omitempty"`
+}
+
+// TableDocFetch describes the list or get call of a table
+type TableDocFetch struct {
+	Hydrate string `json:"hydrate"`
+	// the parent list functions, starting with the outermost
+	ParentHydrate []string             `json:"parent_hydrate,omitempty"`
+	KeyColumns    []*TableDocKeyColumn `json:"key_columns,omitempty"`
+	Tags          map[string]string    `json:"tags,omitempty"`
+}
+
+type TableDocKeyColumn struct {
+	Name      string   `json:"name"`
+	Operators []string `json:"operators"`
+	Require   string   `json:"require"`
+}
+
+type TableDocColumn struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// the hydrate function which populates the column, if this is not the list or get call
+	Hydrate string `json:"hydrate,omitempty"`
+	// the cost hint for the hydrate function (per_row or batched), if this is not the list or get call
+	Cost           string `json:"cost,omitempty"`
+	Sensitivity    string `json:"sensitivity,omitempty"`
+	Deprecated     bool   `json:"deprecated,omitempty"`
+	ReplacedBy     string `json:"replaced_by,omitempty"`
+	RemovalVersion string `json:"removal_version,omitempty"`
+}
+
+// TableDocHydrate describes a column hydrate function (other than the list or get call)
+type TableDocHydrate struct {
+	Name           string            `json:"name"`
+	Cost           string            `json:"cost"`
+	Columns        []string          `json:"columns"`
+	Depends        []string          `json:"depends,omitempty"`
+	MaxConcurrency int               `json:"max_concurrency,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+/*
+GetTableDocs creates the plugin using pluginFunc, sets the given connection config and returns the docs for each table,
+ordered by table name.
+
+connectionConfig is the HCL connection config - this may be empty, unless the plugin needs its config to build the table map
+(i.e. the plugin defines a TableMapFunc which uses the connection config).
+*/
+func GetTableDocs(ctx context.Context, pluginFunc PluginFunc, connectionConfig string) ([]*TableDoc, error) {
+	p, err := newStandalonePlugin(ctx, pluginFunc, connectionConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer p.shutdown()
+
+	connectionData, ok := p.getConnectionData(standaloneConnectionName)
+	if !ok {
+		return nil, fmt.Errorf("GetTableDocs failed - no connection data loaded for plugin '%s'", p.Name)
+	}
+
+	tableNames := maps.Keys(connectionData.TableMap)
+	slices.Sort(tableNames)
+	docs := make([]*TableDoc, len(tableNames))
+	for i, tableName := range tableNames {
+		docs[i] = newTableDoc(connectionData.TableMap[tableName])
+	}
+	return docs, nil
+}
+
+// newTableDoc builds the doc for an initialised table
+func newTableDoc(t *Table) *TableDoc {
+	doc := &TableDoc{
+		Name:           t.Name,
+		Description:    t.Description,
+		Deprecated:     t.Deprecated,
+		ReplacedBy:     t.ReplacedBy,
+		RemovalVersion: t.RemovalVersion,
+		Tags:           docTags(t.Tags),
+	}
+	if t.List != nil {
+		doc.List = &TableDocFetch{
+			Hydrate:    t.List.namedHydrate.Name,
+			KeyColumns: docKeyColumns(t.List.getKeyColumns()),
+			Tags:       docTags(t.List.Tags),
+		}
+		for _, parent := range t.List.parentChain {
+			doc.List.ParentHydrate = append(doc.List.ParentHydrate, parent.namedHydrate.Name)
+		}
+	}
+	if t.Get != nil {
+		doc.Get = &TableDocFetch{
+			Hydrate:    t.Get.namedHydrate.Name,
+			KeyColumns: docKeyColumns(t.Get.KeyColumns),
+			Tags:       docTags(t.Get.Tags),
+		}
+	}
+
+	hydrateDocs := make(map[string]*TableDocHydrate)
+	for _, column := range t.Columns {
+		if IsReservedColumnName(column.Name) {
+			continue
+		}
+		columnDoc := &TableDocColumn{
+			Name:           column.Name,
+			Type:           column.Type.String(),
+			Description:    column.Description,
+			Sensitivity:    string(column.Sensitivity),
+			Deprecated:     column.Deprecated,
+			ReplacedBy:     column.ReplacedBy,
+			RemovalVersion: column.RemovalVersion,
+		}
+		doc.Columns = append(doc.Columns, columnDoc)
+
+		hydrateDoc := t.columnHydrateDoc(column, hydrateDocs)
+		if hydrateDoc == nil {
+			continue
+		}
+		columnDoc.Hydrate = hydrateDoc.Name
+		columnDoc.Cost = hydrateDoc.Cost
+		hydrateDoc.Columns = append(hydrateDoc.Columns, column.Name)
+	}
+
+	hydrateNames := maps.Keys(hydrateDocs)
+	slices.Sort(hydrateNames)
+	for _, name := range hydrateNames {
+		doc.Hydrate = append(doc.Hydrate, hydrateDocs[name])
+	}
+	return doc
+}
+
+// columnHydrateDoc returns the doc for the hydrate function of the column,
+// or nil if the column is populated by the list or get call
+func (t *Table) columnHydrateDoc(column *Column, hydrateDocs map[string]*TableDocHydrate) *TableDocHydrate {
+	if column.Hydrate == nil {
+		return nil
+	}
+	name := column.namedHydrate.Name
+	if t.List != nil && name == t.List.namedHydrate.Name {
+		return nil
+	}
+	// if the table does not have a list call, the get call populates every row
+	if t.List == nil && t.Get != nil && name == t.Get.namedHydrate.Name {
+		return nil
+	}
+	if hydrateDoc, ok := hydrateDocs[name]; ok {
+		return hydrateDoc
+	}
+
+	hydrateDoc := &TableDocHydrate{Name: name, Cost: hydrateCostPerRow}
+	if config, ok := t.hydrateConfigMap[name]; ok {
+		if config.BatchFunc != nil {
+			hydrateDoc.Cost = hydrateCostBatched
+		}
+		for _, dep := range config.Depends {
+			hydrateDoc.Depends = append(hydrateDoc.Depends, newNamedHydrateFunc(dep).Name)
+		}
+		hydrateDoc.MaxConcurrency = config.MaxConcurrency
+		hydrateDoc.Tags = docTags(config.Tags)
+	}
+	hydrateDocs[name] = hydrateDoc
+	return hydrateDoc
+}
+
+func docKeyColumns(keyColumns KeyColumnSlice) []*TableDocKeyColumn {
+	var res []*TableDocKeyColumn
+	for _, k := range keyColumns {
+		res = append(res, &TableDocKeyColumn{
+			Name:      k.Name,
+			Operators: k.Operators,
+			Require:   k.Require,
+		})
+	}
+	return res
+}
+
+// docTags returns the user defined tags, excluding the scope values populated by the SDK
+func docTags(tags map[string]string) map[string]string {
+	var res map[string]string
+	for k, v := range tags {
+		if k == rate_limiter.RateLimiterScopeTable || k == rate_limiter.RateLimiterScopeFunction {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]string)
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// Render returns the doc in the given format
+func (d *TableDoc) Render(format TableDocFormat) ([]byte, error) {
+	switch format {
+	case TableDocFormatJSON:
+		res, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(res, '\n'), nil
+	case TableDocFormatMarkdown:
+		return []byte(d.Markdown()), nil
+	}
+	return nil, fmt.Errorf("invalid table doc format '%s' - must be '%s' or '%s'", format, TableDocFormatMarkdown, TableDocFormatJSON)
+}
+
+// Markdown returns the doc as markdown
+func (d *TableDoc) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString(tableDocHeader + "\n\n")
+	fmt.Fprintf(&sb, "# Table: %s\n\n", d.Name)
+	if d.Description != "" {
+		sb.WriteString(d.Description + "\n\n")
+	}
+	if d.Deprecated {
+		fmt.Fprintf(&sb, "> **Deprecated:** %s\n\n", deprecationMessage("table", d.Name, d.ReplacedBy, d.RemovalVersion))
+	}
+	writeMarkdownTags(&sb, "Tags", d.Tags)
+
+	writeMarkdownFetch(&sb, "List", d.List)
+	writeMarkdownFetch(&sb, "Get", d.Get)
+
+	sb.WriteString("## Columns\n\n")
+	sb.WriteString("| Name | Type | Description | Hydrate | Cost |\n")
+	sb.WriteString("|------|------|-------------|---------|------|\n")
+	for _, c := range d.Columns {
+		description := c.Description
+		if c.Deprecated {
+			description = strings.TrimSpace(fmt.Sprintf("**Deprecated:** %s. %s", deprecationMessage("column", c.Name, c.ReplacedBy, c.RemovalVersion), description))
+		}
+		if c.Sensitivity != "" {
+			description = strings.TrimSpace(fmt.Sprintf("%s (sensitivity: %s)", description, c.Sensitivity))
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s |\n", c.Name, c.Type, markdownCell(description), markdownCode(c.Hydrate), markdownCost(c.Cost))
+	}
+
+	if len(d.Hydrate) > 0 {
+		sb.WriteString("\n## Hydrate functions\n\n")
+		sb.WriteString("| Name | Cost | Columns | Depends on | Max concurrency | Tags |\n")
+		sb.WriteString("|------|------|---------|------------|-----------------|------|\n")
+		for _, h := range d.Hydrate {
+			maxConcurrency := ""
+			if h.MaxConcurrency > 0 {
+				maxConcurrency = fmt.Sprintf("%d", h.MaxConcurrency)
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s |\n",
+				markdownCode(h.Name),
+				markdownCost(h.Cost),
+				strings.Join(h.Columns, ", "),
+				markdownCodeList(h.Depends),
+				maxConcurrency,
+				markdownCell(formatDocTags(h.Tags)))
+		}
+	}
+	return sb.String()
+}
+
+func writeMarkdownFetch(sb *strings.Builder, title string, fetch *TableDocFetch) {
+	fmt.Fprintf(sb, "## %s\n\n", title)
+	if fetch == nil {
+		sb.WriteString("Not supported.\n\n")
+		return
+	}
+	fmt.Fprintf(sb, "Hydrate: %s", markdownCode(fetch.Hydrate))
+	if len(fetch.ParentHydrate) > 0 {
+		fmt.Fprintf(sb, " (parent: %s)", markdownCodeList(fetch.ParentHydrate))
+	}
+	sb.WriteString("\n\n")
+	writeMarkdownTags(sb, "Tags", fetch.Tags)
+	if len(fetch.KeyColumns) == 0 {
+		return
+	}
+	sb.WriteString("| Key column | Operators | Require |\n")
+	sb.WriteString("|------------|-----------|---------|\n")
+	for _, k := range fetch.KeyColumns {
+		fmt.Fprintf(sb, "| %s | %s | %s |\n", k.Name, markdownCell(markdownCodeList(k.Operators)), k.Require)
+	}
+	sb.WriteString("\n")
+}
+
+func writeMarkdownTags(sb *strings.Builder, title string, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "%s: %s\n\n", title, formatDocTags(tags))
+}
+
+// formatDocTags returns the tags as a sorted, comma separated list of key=value
+func formatDocTags(tags map[string]string) string {
+	keys := maps.Keys(tags)
+	slices.Sort(keys)
+	items := make([]string, len(keys))
+	for i, k := range keys {
+		items[i] = fmt.Sprintf("%s=%s", k, tags[k])
+	}
+	return strings.Join(items, ", ")
+}
+
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
+
+func markdownCodeList(items []string) string {
+	res := make([]string, len(items))
+	for i, item := range items {
+		res[i] = markdownCode(item)
+	}
+	return strings.Join(res, ", ")
+}
+
+func markdownCost(cost string) string {
+	return strings.ReplaceAll(cost, "_", " ")
+}
+
+// markdownCell escapes a value for use in a markdown table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// WriteTableDocs writes a doc file for each table to dir, named '<table name>.md' or '<table name>.json'
+func WriteTableDocs(docs []*TableDoc, dir string, format TableDocFormat) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		content, err := doc.Render(format)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, doc.Name+format.extension())
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] wrote %d table docs to %s", len(docs), dir)
+	return nil
+}
+
+// CheckTableDocs compares the docs with the doc files in dir, and returns an error listing any which are stale:
+//   - doc files which are missing or do not match the generated doc
+//   - doc files which do not correspond to a table
+func CheckTableDocs(docs []*TableDoc, dir string, format TableDocFormat) error {
+	var stale []string
+	expectedFiles := make(map[string]struct{}, len(docs))
+	for _, doc := range docs {
+		content, err := doc.Render(format)
+		if err != nil {
+			return err
+		}
+		fileName := doc.Name + format.extension()
+		expectedFiles[fileName] = struct{}{}
+
+		existing, err := os.ReadFile(filepath.Join(dir, fileName))
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			stale = append(stale, fmt.Sprintf("%s is missing", fileName))
+		case err != nil:
+			return err
+		case string(existing) != string(content):
+			stale = append(stale, fmt.Sprintf("%s is out of date", fileName))
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+format.extension()))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		fileName := filepath.Base(file)
+		if _, ok := expectedFiles[fileName]; !ok {
+			stale = append(stale, fmt.Sprintf("%s does not correspond to a table", fileName))
+		}
+	}
+
+	if len(stale) > 0 {
+		return fmt.Errorf("table docs in %s are stale - regenerate using WriteTableDocs:\n  - %s", dir, strings.Join(stale, "\n  - "))
+	}
+	return nil
+}
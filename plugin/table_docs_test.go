@@ -0,0 +1,172 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+func batchHydrate(context.Context, *QueryData, []*HydrateData) ([]interface{}, error) {
+	return nil, nil
+}
+
+func tableDocsTestPlugin(context.Context) *Plugin {
+	return &Plugin{
+		Name: "table_docs_test",
+		TableMap: map[string]*Table{
+			"thing": {
+				Name:        "thing",
+				Description: "Things | stuff.",
+				List: &ListConfig{
+					Hydrate:       listHydrate,
+					ParentHydrate: hydrate4,
+					KeyColumns: []*KeyColumn{
+						{Name: "region", Require: Required},
+						{Name: "name", Operators: []string{"=", "<>"}, Require: Optional},
+					},
+					Tags: map[string]string{"service": "things"},
+				},
+				Get: &GetConfig{
+					Hydrate:    getHydrate,
+					KeyColumns: SingleColumn("id"),
+				},
+				HydrateConfig: []HydrateConfig{
+					{Func: hydrate1, Depends: []HydrateFunc{hydrate2}, MaxConcurrency: 5, Tags: map[string]string{"action": "Describe"}},
+					{Func: hydrate3, BatchFunc: batchHydrate},
+				},
+				Columns: []*Column{
+					{Name: "id", Type: proto.ColumnType_STRING, Description: "The id."},
+					{Name: "name", Type: proto.ColumnType_STRING, Description: "The name."},
+					{Name: "region", Type: proto.ColumnType_STRING, Description: "The region."},
+					{Name: "details", Type: proto.ColumnType_JSON, Hydrate: getHydrate, Description: "The details."},
+					{Name: "tags", Type: proto.ColumnType_JSON, Hydrate: hydrate1, Description: "The tags."},
+					{Name: "policy", Type: proto.ColumnType_JSON, Hydrate: hydrate2, Sensitivity: SensitivitySecret},
+					{Name: "size", Type: proto.ColumnType_INT, Hydrate: hydrate3},
+					{Name: "region_name", Type: proto.ColumnType_STRING, Deprecated: true, ReplacedBy: "region"},
+				},
+			},
+			"get_only": {
+				Name: "get_only",
+				Get: &GetConfig{
+					Hydrate:    getHydrate,
+					KeyColumns: SingleColumn("id"),
+				},
+				Columns: []*Column{
+					{Name: "id", Type: proto.ColumnType_STRING, Hydrate: getHydrate},
+				},
+			},
+		},
+	}
+}
+
+const expectedThingMarkdown = "<!-- generated from the table definition - do not edit -->\n" + `
+# Table: thing
+
+Things | stuff.
+
+## List
+
+Hydrate: ` + "`listHydrate` (parent: `hydrate4`)" + `
+
+Tags: service=things
+
+| Key column | Operators | Require |
+|------------|-----------|---------|
+| region | ` + "`=`" + ` | required |
+| name | ` + "`=`, `<>`" + ` | optional |
+
+## Get
+
+Hydrate: ` + "`getHydrate`" + `
+
+| Key column | Operators | Require |
+|------------|-----------|---------|
+| id | ` + "`=`" + ` | required |
+
+## Columns
+
+| Name | Type | Description | Hydrate | Cost |
+|------|------|-------------|---------|------|
+| id | STRING | The id. |  |  |
+| name | STRING | The name. |  |  |
+| region | STRING | The region. |  |  |
+| details | JSON | The details. | ` + "`getHydrate`" + ` | per row |
+| tags | JSON | The tags. | ` + "`hydrate1`" + ` | per row |
+| policy | JSON | (sensitivity: secret) | ` + "`hydrate2`" + ` | per row |
+| size | INT |  | ` + "`hydrate3`" + ` | batched |
+| region_name | STRING | **Deprecated:** column 'region_name' is deprecated - use 'region' instead. |  |  |
+
+## Hydrate functions
+
+| Name | Cost | Columns | Depends on | Max concurrency | Tags |
+|------|------|---------|------------|-----------------|------|
+| ` + "`getHydrate`" + ` | per row | details |  |  |  |
+| ` + "`hydrate1`" + ` | per row | tags | ` + "`hydrate2`" + ` | 5 | action=Describe |
+| ` + "`hydrate2`" + ` | per row | policy |  |  |  |
+| ` + "`hydrate3`" + ` | batched | size |  |  |  |
+`
+
+func TestGetTableDocs(t *testing.T) {
+	docs, err := GetTableDocs(context.Background(), tableDocsTestPlugin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 2 || docs[0].Name != "get_only" || docs[1].Name != "thing" {
+		t.Fatalf("expected docs for tables 'get_only' and 'thing'")
+	}
+
+	// a table with no list call is populated by the get call, so the get hydrate has no cost
+	getOnly := docs[0]
+	if getOnly.List != nil || getOnly.Get == nil || len(getOnly.Hydrate) != 0 || getOnly.Columns[0].Cost != "" {
+		t.Errorf("unexpected doc for get_only table: %+v", getOnly)
+	}
+
+	thing := docs[1]
+	// the reserved columns are not included
+	if len(thing.Columns) != 8 {
+		t.Errorf("expected 8 columns, got %d", len(thing.Columns))
+	}
+	if actual := thing.Markdown(); actual != expectedThingMarkdown {
+		t.Errorf("unexpected markdown.\nexpected:\n%s\ngot:\n%s", expectedThingMarkdown, actual)
+	}
+}
+
+func TestCheckTableDocs(t *testing.T) {
+	docs, err := GetTableDocs(context.Background(), tableDocsTestPlugin, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []TableDocFormat{TableDocFormatMarkdown, TableDocFormatJSON} {
+		dir := t.TempDir()
+		if err := CheckTableDocs(docs, dir, format); err == nil || !strings.Contains(err.Error(), "thing"+format.extension()+" is missing") {
+			t.Errorf("%s: expected missing doc error, got %v", format, err)
+		}
+
+		if err := WriteTableDocs(docs, dir, format); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckTableDocs(docs, dir, format); err != nil {
+			t.Errorf("%s: expected docs to be up to date, got %s", format, err.Error())
+		}
+
+		// change the table definition
+		docs[1].Columns[0].Description = "The changed id."
+		if err := CheckTableDocs(docs, dir, format); err == nil || !strings.Contains(err.Error(), "thing"+format.extension()+" is out of date") {
+			t.Errorf("%s: expected out of date error, got %v", format, err)
+		}
+		docs[1].Columns[0].Description = "The id."
+
+		// add a doc for a removed table
+		if err := os.WriteFile(filepath.Join(dir, "removed"+format.extension()), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckTableDocs(docs, dir, format); err == nil || !strings.Contains(err.Error(), "removed"+format.extension()+" does not correspond to a table") {
+			t.Errorf("%s: expected orphaned doc error, got %v", format, err)
+		}
+	}
+}
@@ -134,6 +134,11 @@ func (t *Table) getListQualValueForGetCall(queryData *QueryData) (string, *proto
 }
 
 func (t *Table) doGetForQualValues(ctx context.Context, queryData *QueryData, keyColumnName string, qualValueList *proto.QualValueList) error {
+	// if there is a batch get function, use it
+	if t.Get.BatchHydrate != nil {
+		return t.doBatchGetForQualValues(ctx, queryData, keyColumnName, qualValueList)
+	}
+
 	log.Printf("[TRACE] doGetForQualValues - single qual, qual value is a list - executing get for each qual value item, qualValueList: %v", qualValueList)
 
 	var getWg sync.WaitGroup
@@ -208,7 +213,7 @@ func (t *Table) doGet(ctx context.Context, queryData *QueryData) (err error) {
 		// NOTE: explicitly set the get hydrate results on rowData
 		rd.set(hydrateKey, rd.item)
 		// set the rowsStreamed to 1
-		queryData.queryStatus.rowsStreamed = 1
+		queryData.queryStatus.rowsStreamed.Store(1)
 		// send the result down the stream
 		queryData.rowDataChan <- rd
 	}
@@ -228,7 +233,8 @@ func (t *Table) get(ctx context.Context, queryData *QueryData) (*rowData, error)
 	rd := newRowData(queryData, nil)
 	// just invoke callHydrateWithRetries()
 	var getItem any
-	getItem, err := rd.callHydrateWithRetries(ctx, queryData, t.Get.namedHydrate, t.Get.IgnoreConfig, t.Get.RetryConfig)
+	getHydrate := queryData.fetchCircuitBreaker.wrap(withTimeout(t.Get.namedHydrate, t.Get.Timeout), t.Get.IgnoreConfig)
+	getItem, err := rd.callHydrateWithRetries(ctx, queryData, getHydrate, t.Get.IgnoreConfig, t.Get.RetryConfig)
 	rd.item = getItem
 	return rd, err
 }
@@ -245,9 +251,18 @@ func (t *Table) getForEachMatrixItem(ctx context.Context, queryData *QueryData)
 	resultChan := make(chan *rowData, len(queryData.Matrix))
 	var results []*rowData
 
+	// limit the number of matrix items fetched concurrently
+	matrixSemaphore := t.MatrixConfig.newSemaphore()
+
 	// NOTE - we use the filtered matrix - which means we may not actually run any hydrate calls
 	// if the quals have filtered out all matrix items (e.g. select where region = 'invalid')
 	for _, matrixItem := range queryData.filteredMatrix {
+		if matrixSemaphore != nil {
+			if err := matrixSemaphore.Acquire(ctx, 1); err != nil {
+				errorChan <- err
+				break
+			}
+		}
 
 		// increment our own wait group
 		wg.Add(1)
@@ -262,6 +277,9 @@ func (t *Table) getForEachMatrixItem(ctx context.Context, queryData *QueryData)
 						errorChan <- fmt.Errorf("%v", r)
 					}
 				}
+				if matrixSemaphore != nil {
+					matrixSemaphore.Release(1)
+				}
 				wg.Done()
 			}()
 			// create a context with the matrix item
@@ -278,9 +296,13 @@ func (t *Table) getForEachMatrixItem(ctx context.Context, queryData *QueryData)
 			matrixQueryData.setGetLimiterMetadata(fetchDelay)
 			// create a rowData for each matrix item
 			matrixRd := newRowData(matrixQueryData, nil)
+			matrixRd.matrixItem = matrixItem
 
 			// now call hydrate from the matrix rowdata
-			item, err := matrixRd.callHydrateWithRetries(fetchContext, matrixQueryData, t.Get.namedHydrate, t.Get.IgnoreConfig, t.Get.RetryConfig)
+			getHydrate := matrixQueryData.fetchCircuitBreaker.wrap(withTimeout(t.Get.namedHydrate, t.Get.Timeout), t.Get.IgnoreConfig)
+			item, err := matrixRd.callHydrateWithRetries(fetchContext, matrixQueryData, getHydrate, t.Get.IgnoreConfig, t.Get.RetryConfig)
+			// apply the matrix failure policy
+			err = matrixQueryData.onMatrixItemFetched(fetchContext, getHydrate.Name, err)
 
 			if err != nil {
 				log.Printf("[WARN] callHydrateWithRetries returned error %v", err)
@@ -365,7 +387,7 @@ func (t *Table) executeListCall(ctx context.Context, queryData *QueryData) {
 	}()
 
 	// verify we have the necessary quals
-	unsatisfiedColumns := queryData.Quals.GetUnsatisfiedKeyColumns(t.List.KeyColumns)
+	unsatisfiedColumns := queryData.Quals.GetUnsatisfiedKeyColumns(t.List.getKeyColumns())
 	if len(unsatisfiedColumns) > 0 {
 		err := t.buildMissingKeyColumnError("List", unsatisfiedColumns)
 		queryData.streamError(err)
@@ -377,8 +399,8 @@ func (t *Table) executeListCall(ctx context.Context, queryData *QueryData) {
 	listCall := t.List.namedHydrate
 	// if there is a parent hydrate function, call that
 	// - the child 'Hydrate' function will be called by QueryData.StreamListItem,
-	if t.List.ParentHydrate != nil {
-		listCall = t.List.namedParentHydrate
+	if len(t.List.parentChain) > 0 {
+		listCall = t.List.parentChain[0].namedHydrate
 		childHydrate = t.List.namedHydrate
 	}
 
@@ -400,7 +422,7 @@ func (t *Table) executeListCall(ctx context.Context, queryData *QueryData) {
 // if this table defines key columns, and if there is a SINGLE qual with a list value
 // return that qual
 func (t *Table) getListCallQualValueList(queryData *QueryData) *quals.Qual {
-	if len(t.List.KeyColumns) == 0 {
+	if len(t.List.getKeyColumns()) == 0 {
 		return nil
 	}
 
@@ -427,7 +449,7 @@ func (t *Table) getListCallQualValueList(queryData *QueryData) *quals.Qual {
 			for _, listQual := range qualsWithListValues {
 
 				// find key column
-				if c := t.List.KeyColumns.Find(listQual.Column); c.Require == Required {
+				if c := t.List.getKeyColumns().Find(listQual.Column); c.Require == Required {
 					requiredListQuals = append(requiredListQuals, listQual)
 				}
 			}
@@ -498,10 +520,20 @@ func (t *Table) doList(ctx context.Context, queryData *QueryData, listCall named
 
 	log.Printf("[TRACE] doList: no matrix item")
 
+	// if this is the outermost parent list call, apply its concurrency limit
+	release, err := queryData.parentListSemaphores.acquire(ctx, 0)
+	if err != nil {
+		queryData.streamError(err)
+		return
+	}
+	defer release()
+
+	ignoreConfig, retryConfig, timeout := t.List.getListCallConfig()
 	// we cannot retry errors in the list hydrate function after streaming has started
-	listRetryConfig := t.List.RetryConfig.GetListRetryConfig()
+	listRetryConfig := retryConfig.GetListRetryConfig()
 
-	if _, err := rd.callHydrateWithRetries(ctx, queryData, listCall, t.List.IgnoreConfig, listRetryConfig); err != nil {
+	listHydrate := queryData.fetchCircuitBreaker.wrap(withTimeout(listCall, timeout), ignoreConfig)
+	if _, err := rd.callHydrateWithRetries(ctx, queryData, listHydrate, ignoreConfig, listRetryConfig); err != nil {
 		log.Printf("[WARN] doList callHydrateWithRetries (%s) returned err %s", queryData.connectionCallId, err.Error())
 		queryData.streamError(err)
 	}
@@ -517,9 +549,18 @@ func (t *Table) listForEachMatrixItem(ctx context.Context, queryData *QueryData,
 
 	log.Printf("[TRACE] listForEachMatrixItem: %v\n", queryData.Matrix)
 	var wg sync.WaitGroup
+	// limit the number of matrix items fetched concurrently
+	matrixSemaphore := t.MatrixConfig.newSemaphore()
+
 	// NOTE - we use the filtered matrix - which means we may not actually run any hydrate calls
 	// if the quals have filtered out all matrix items (e.g. select where region = 'invalid')
 	for _, matrixItem := range queryData.filteredMatrix {
+		if matrixSemaphore != nil {
+			if err := matrixSemaphore.Acquire(ctx, 1); err != nil {
+				queryData.streamError(err)
+				break
+			}
+		}
 		// create a context with the matrixItem
 		fetchContext := context.WithValue(ctx, context_key.MatrixItem, matrixItem)
 		wg.Add(1)
@@ -530,6 +571,9 @@ func (t *Table) listForEachMatrixItem(ctx context.Context, queryData *QueryData,
 				if r := recover(); r != nil {
 					queryData.streamError(helpers.ToError(r))
 				}
+				if matrixSemaphore != nil {
+					matrixSemaphore.Release(1)
+				}
 				wg.Done()
 			}()
 			// create rowData, purely so we can call callHydrateWithRetries
@@ -547,10 +591,22 @@ func (t *Table) listForEachMatrixItem(ctx context.Context, queryData *QueryData,
 			// set the metadata
 			matrixQueryData.setListMetadata(fetchDelay)
 
+			// if this is the outermost parent list call, apply its concurrency limit
+			release, err := matrixQueryData.parentListSemaphores.acquire(ctx, 0)
+			if err != nil {
+				queryData.streamError(err)
+				return
+			}
+			defer release()
+
+			ignoreConfig, retryConfig, timeout := t.List.getListCallConfig()
 			// we cannot retry errors in the list hydrate function after streaming has started
-			listRetryConfig := t.List.RetryConfig.GetListRetryConfig()
+			listRetryConfig := retryConfig.GetListRetryConfig()
 
-			_, err := rd.callHydrateWithRetries(fetchContext, matrixQueryData, listCall, t.List.IgnoreConfig, listRetryConfig)
+			listHydrate := matrixQueryData.fetchCircuitBreaker.wrap(withTimeout(listCall, timeout), ignoreConfig)
+			_, err = rd.callHydrateWithRetries(fetchContext, matrixQueryData, listHydrate, ignoreConfig, listRetryConfig)
+			// apply the matrix failure policy
+			err = matrixQueryData.onMatrixItemFetched(fetchContext, listHydrate.Name, err)
 			if err != nil {
 				log.Printf("[WARN] callHydrateWithRetries returned error %v", err)
 				queryData.streamError(err)
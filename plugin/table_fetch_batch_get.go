@@ -0,0 +1,206 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+)
+
+const defaultGetBatchSize = 100
+
+/*
+GetBatchHydrateFunc is a batch version of the Get [HydrateFunc], used when a query has an IN-list qual for a get key column:
+
+	select * from aws_s3_bucket where name in ('bucket-a', 'bucket-b', 'bucket-c')
+
+It is passed the key column name and a chunk of the qual values (of at most [plugin.GetConfig.BatchSize] values),
+and returns the items for all values which exist. Values which do not exist should be omitted from the result.
+
+The chunk of qual values is also set as the list value of the key column in [plugin.QueryData.EqualsQuals].
+*/
+type GetBatchHydrateFunc func(ctx context.Context, d *QueryData, keyColumn string, values *proto.QualValueList) ([]interface{}, error)
+
+// doBatchGetForQualValues calls the get BatchHydrate function for chunks of the qual values
+func (t *Table) doBatchGetForQualValues(ctx context.Context, queryData *QueryData, keyColumnName string, qualValueList *proto.QualValueList) error {
+	chunks := chunkQualValueList(qualValueList, t.Get.BatchSize)
+	log.Printf("[TRACE] doBatchGetForQualValues - executing batch get for %d qual values in %d chunks", len(qualValueList.Values), len(chunks))
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var rows []*rowData
+	var errors []error
+
+	for _, chunk := range chunks {
+		// make a shallow copy of the query data and modify the quals to contain the chunk of values
+		queryDataCopy := queryData.shallowCopy()
+		qv := &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: chunk}}
+		queryDataCopy.EqualsQuals[keyColumnName] = qv
+		queryDataCopy.Quals[keyColumnName] =
+			&KeyColumnQuals{Name: keyColumnName, Quals: quals.QualSlice{{Column: keyColumnName, Operator: "=", Value: qv}}}
+
+		wg.Add(1)
+		go func(chunk *proto.QualValueList) {
+			defer wg.Done()
+			chunkRows, err := t.batchGet(ctx, queryDataCopy, keyColumnName, chunk)
+			mut.Lock()
+			defer mut.Unlock()
+			if err != nil {
+				errors = append(errors, err)
+				return
+			}
+			rows = append(rows, chunkRows...)
+		}(chunk)
+	}
+	wg.Wait()
+
+	if err := buildSingleError(errors); err != nil {
+		log.Printf("[WARN] doBatchGetForQualValues returned an error: %v", err)
+		return err
+	}
+
+	// NOTE: ensure QueryData.rowDataChan can buffer all items
+	// (the rows are not read from the channel until the get call is complete)
+	if len(rows) > cap(queryData.rowDataChan) {
+		queryData.rowDataChan = make(chan *rowData, len(rows))
+	}
+	for _, rd := range rows {
+		queryData.rowDataChan <- rd
+	}
+	if len(rows) > 0 {
+		// set the rowsStreamed to 1
+		queryData.queryStatus.rowsStreamed.Store(1)
+	}
+	return nil
+}
+
+// batchGet calls the get BatchHydrate function for a single chunk of qual values,
+// calling for every matrix item if a matrix is defined
+func (t *Table) batchGet(ctx context.Context, queryData *QueryData, keyColumnName string, values *proto.QualValueList) ([]*rowData, error) {
+	if len(queryData.Matrix) == 0 {
+		return t.batchGetForMatrixItem(ctx, queryData, keyColumnName, values, nil)
+	}
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var rows []*rowData
+	var errors []error
+
+	// limit the number of matrix items fetched concurrently
+	matrixSemaphore := t.MatrixConfig.newSemaphore()
+
+	// NOTE - we use the filtered matrix - which means we may not actually run any hydrate calls
+	// if the quals have filtered out all matrix items (e.g. select where region = 'invalid')
+	for _, matrixItem := range queryData.filteredMatrix {
+		if matrixSemaphore != nil {
+			if err := matrixSemaphore.Acquire(ctx, 1); err != nil {
+				mut.Lock()
+				errors = append(errors, err)
+				mut.Unlock()
+				break
+			}
+		}
+		wg.Add(1)
+		go func(matrixItem map[string]any) {
+			defer func() {
+				if r := recover(); r != nil {
+					mut.Lock()
+					errors = append(errors, helpers.ToError(r))
+					mut.Unlock()
+				}
+				if matrixSemaphore != nil {
+					matrixSemaphore.Release(1)
+				}
+				wg.Done()
+			}()
+			// clone the query data and add the matrix properties to quals
+			matrixQueryData := queryData.shallowCopy()
+			matrixQueryData.setMatrixItem(matrixItem)
+
+			matrixRows, err := t.batchGetForMatrixItem(ctx, matrixQueryData, keyColumnName, values, matrixItem)
+			// apply the matrix failure policy
+			err = matrixQueryData.onMatrixItemFetched(ctx, helpers.GetFunctionName(t.Get.BatchHydrate), err)
+			mut.Lock()
+			defer mut.Unlock()
+			if err != nil {
+				errors = append(errors, err)
+				return
+			}
+			rows = append(rows, matrixRows...)
+		}(matrixItem)
+	}
+	wg.Wait()
+
+	return rows, buildSingleError(errors)
+}
+
+func (t *Table) batchGetForMatrixItem(ctx context.Context, queryData *QueryData, keyColumnName string, values *proto.QualValueList, matrixItem map[string]any) ([]*rowData, error) {
+	if matrixItem != nil {
+		ctx = context.WithValue(ctx, context_key.MatrixItem, matrixItem)
+	}
+	// initialise the rate limiters for this query data
+	queryData.initialiseRateLimiters()
+	// now wait for any configured 'get' rate limiters
+	fetchDelay := queryData.fetchLimiters.wait(ctx)
+	// set the metadata
+	queryData.setGetLimiterMetadata(fetchDelay)
+
+	// wrap the batch func as a hydrate func, so it is called with the same
+	// timeout, circuit breaker, ignore and retry behaviour as the get call
+	batchHydrate := namedHydrateFunc{
+		Name: helpers.GetFunctionName(t.Get.BatchHydrate),
+		Func: func(ctx context.Context, d *QueryData, _ *HydrateData) (interface{}, error) {
+			return t.Get.BatchHydrate(ctx, d, keyColumnName, values)
+		},
+	}
+	batchHydrate = queryData.fetchCircuitBreaker.wrap(withTimeout(batchHydrate, t.Get.Timeout), t.Get.IgnoreConfig)
+
+	rd := newRowData(queryData, nil)
+	result, err := rd.callHydrateWithRetries(ctx, queryData, batchHydrate, t.Get.IgnoreConfig, t.Get.RetryConfig)
+	if err != nil {
+		log.Printf("[WARN] table '%s': Get BatchHydrate call %s returned error %v\n", t.Name, batchHydrate.Name, err)
+		return nil, err
+	}
+	// if the error was ignored, the result will be nil
+	if helpers.IsNil(result) {
+		return nil, nil
+	}
+	items, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("table '%s': Get BatchHydrate call %s returned %T, expected []interface{}", t.Name, batchHydrate.Name, result)
+	}
+
+	hydrateKey := t.Get.namedHydrate.Name
+	var rows []*rowData
+	for _, item := range items {
+		// skip nil items - we assume the item does not exist
+		if helpers.IsNil(item) {
+			continue
+		}
+		itemRd := newRowData(queryData, item)
+		if matrixItem != nil {
+			itemRd.matrixItem = matrixItem
+		}
+		// NOTE: explicitly set the get hydrate results on rowData
+		itemRd.set(hydrateKey, item)
+		rows = append(rows, itemRd)
+	}
+	return rows, nil
+}
+
+// chunkQualValueList splits the qual value list into chunks of at most chunkSize values
+func chunkQualValueList(qualValueList *proto.QualValueList, chunkSize int) []*proto.QualValueList {
+	var res []*proto.QualValueList
+	values := qualValueList.Values
+	for len(values) > 0 {
+		n := min(chunkSize, len(values))
+		res = append(res, &proto.QualValueList{Values: values[:n]})
+		values = values[n:]
+	}
+	return res
+}
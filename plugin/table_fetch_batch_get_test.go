@@ -0,0 +1,55 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+)
+
+func TestChunkQualValueList(t *testing.T) {
+	values := &proto.QualValueList{}
+	for i := 0; i < 5; i++ {
+		values.Values = append(values.Values, &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: int64(i)}})
+	}
+
+	testCases := map[string]struct {
+		chunkSize int
+		expected  []int
+	}{
+		"smaller chunks": {chunkSize: 2, expected: []int{2, 2, 1}},
+		"exact chunk":    {chunkSize: 5, expected: []int{5}},
+		"larger chunk":   {chunkSize: 100, expected: []int{5}},
+	}
+	for name, test := range testCases {
+		chunks := chunkQualValueList(values, test.chunkSize)
+		if len(chunks) != len(test.expected) {
+			t.Errorf("test %s: expected %d chunks, got %d", name, len(test.expected), len(chunks))
+			continue
+		}
+		for i, chunk := range chunks {
+			if len(chunk.Values) != test.expected[i] {
+				t.Errorf("test %s: chunk %d: expected %d values, got %d", name, i, test.expected[i], len(chunk.Values))
+			}
+		}
+	}
+}
+
+func TestGetConfigBatchSize(t *testing.T) {
+	batchGet := func(context.Context, *QueryData, string, *proto.QualValueList) ([]interface{}, error) {
+		return nil, nil
+	}
+	table := &Table{Name: "table", Plugin: &Plugin{Name: "plugin"}}
+
+	c := &GetConfig{Hydrate: getHydrate, KeyColumns: SingleColumn("name"), BatchHydrate: batchGet}
+	c.initialise(table)
+	if c.BatchSize != defaultGetBatchSize {
+		t.Errorf("expected BatchSize to default to %d, got %d", defaultGetBatchSize, c.BatchSize)
+	}
+
+	c = &GetConfig{Hydrate: getHydrate, KeyColumns: SingleColumn("name"), BatchHydrate: batchGet, BatchSize: -1}
+	table.Get = c
+	if errs := c.Validate(table); len(errs) != 1 || errs[0] != "table 'table' GetConfig BatchSize cannot be negative" {
+		t.Errorf("expected a BatchSize validation error, got %v", errs)
+	}
+}
@@ -47,8 +47,11 @@ func connectionNameColumnDefinition() *proto.ColumnDefinition {
 // (Currently this is populated with the connection name.)
 func (t *Table) GetSchema() (*proto.TableSchema, error) {
 	schema := &proto.TableSchema{
-		Columns:     make([]*proto.ColumnDefinition, 0, len(t.Columns)+1),
-		Description: t.Description,
+		Columns:        make([]*proto.ColumnDefinition, 0, len(t.Columns)+1),
+		Description:    t.Description,
+		Deprecated:     t.Deprecated,
+		ReplacedBy:     t.ReplacedBy,
+		RemovalVersion: t.RemovalVersion,
 	}
 
 	// NOTE: we add a column "_ctx" to all tables.
@@ -58,10 +61,14 @@ func (t *Table) GetSchema() (*proto.TableSchema, error) {
 		// if this is NOT a reserved name, add
 		if !IsReservedColumnName(column.Name) {
 			columnDef := &proto.ColumnDefinition{
-				Name:        column.Name,
-				Type:        column.Type,
-				Description: column.Description,
-				SortOrder:   column.Sort.toProto(),
+				Name:           column.Name,
+				Type:           column.Type,
+				Description:    column.Description,
+				SortOrder:      column.Sort.toProto(),
+				Sensitivity:    string(column.Sensitivity),
+				Deprecated:     column.Deprecated,
+				ReplacedBy:     column.ReplacedBy,
+				RemovalVersion: column.RemovalVersion,
 			}
 			if column.Hydrate != nil {
 				columnDef.Hydrate = column.namedHydrate.Name
@@ -89,8 +96,8 @@ func (t *Table) GetSchema() (*proto.TableSchema, error) {
 		schema.GetCallKeyColumnList = t.Get.KeyColumns.ToProtobuf()
 	}
 	if t.List != nil {
-		if len(t.List.KeyColumns) > 0 {
-			schema.ListCallKeyColumnList = t.List.KeyColumns.ToProtobuf()
+		if len(t.List.getKeyColumns()) > 0 {
+			schema.ListCallKeyColumnList = t.List.getKeyColumns().ToProtobuf()
 		}
 	}
 
@@ -26,11 +26,17 @@ func (t *Table) validate(name string, requiredColumns []*Column) (validationWarn
 	// verify hydrate dependencies are valid
 	// the map entries are strings - ensure they correspond to actual functions
 	validationErrors = append(validationErrors, t.validateHydrateDependencies()...)
+	validationErrors = append(validationErrors, t.validateExplodeHydrate()...)
 
 	validationErrors = append(validationErrors, t.DefaultRetryConfig.validate(t)...)
 
 	validationErrors = append(validationErrors, t.DefaultIgnoreConfig.validate(t)...)
 
+	validationErrors = append(validationErrors, validateTimeout(t.DefaultTimeout, "DefaultTimeout", t)...)
+	validationErrors = append(validationErrors, validateTimeout(t.QueryTimeout, "QueryTimeout", t)...)
+	validationErrors = append(validationErrors, t.MatrixConfig.validate(t)...)
+	validationErrors = append(validationErrors, t.validateDeprecation()...)
+
 	for _, h := range t.hydrateConfigMap {
 		validationErrors = append(validationErrors, h.validate(t)...)
 	}
@@ -182,13 +188,13 @@ func (t *Table) validateKeyColumns() []string {
 		}
 	}
 
-	if t.List != nil && len(t.List.KeyColumns) > 0 {
-		listValidationErrors = t.List.KeyColumns.Validate()
+	if t.List != nil && len(t.List.getKeyColumns()) > 0 {
+		listValidationErrors = t.List.getKeyColumns().Validate()
 		if len(listValidationErrors) > 0 {
 			listValidationErrors = append([]string{fmt.Sprintf("table '%s' has an invalid List config:", t.Name)}, helpers.TabifyStringSlice(listValidationErrors, "    - ")...)
 		}
 		// ensure all key columns actually exist
-		listValidationErrors = append(listValidationErrors, t.validateColumnsExist(t.List.KeyColumns)...)
+		listValidationErrors = append(listValidationErrors, t.validateColumnsExist(t.List.getKeyColumns())...)
 	}
 
 	return append(getValidationErrors, listValidationErrors...)
@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+/*
+HydrateTimeoutError is returned when a single [HydrateFunc] call exceeds its timeout.
+
+Per-call timeouts are set using [plugin.HydrateConfig.Timeout], [plugin.ListConfig.Timeout] and [plugin.GetConfig.Timeout],
+or defaulted for all calls using [plugin.Table.DefaultTimeout] and [plugin.Plugin.DefaultTimeout].
+
+Timeouts are a distinct error class, so they may be retried or ignored by the [RetryConfig] and [IgnoreConfig] of the call:
+
+	RetryConfig: &plugin.RetryConfig{
+		ShouldRetryErrorFunc: func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+			return plugin.IsHydrateTimeoutError(err) || isThrottlingError(err)
+		},
+	},
+
+HydrateTimeoutError wraps context.DeadlineExceeded.
+*/
+type HydrateTimeoutError struct {
+	// the name of the hydrate function which timed out
+	FuncName string
+	// the timeout which was exceeded
+	Timeout time.Duration
+}
+
+func (e *HydrateTimeoutError) Error() string {
+	return fmt.Sprintf("hydrate call %s timed out after %s", e.FuncName, e.Timeout)
+}
+
+func (e *HydrateTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// IsHydrateTimeoutError returns whether the error is (or wraps) a [HydrateTimeoutError]
+func IsHydrateTimeoutError(err error) bool {
+	var timeoutErr *HydrateTimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// QueryTimeoutError is returned when a query exceeds the query timeout
+// set by [plugin.Table.QueryTimeout] or [plugin.Plugin.DefaultQueryTimeout]
+type QueryTimeoutError struct {
+	// the table being queried
+	Table string
+	// the timeout which was exceeded
+	Timeout time.Duration
+}
+
+func (e *QueryTimeoutError) Error() string {
+	return fmt.Sprintf("query of table %s timed out after %s", e.Table, e.Timeout)
+}
+
+func (e *QueryTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// IsQueryTimeoutError returns whether the error is (or wraps) a [QueryTimeoutError]
+func IsQueryTimeoutError(err error) bool {
+	var timeoutErr *QueryTimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+/*
+RemainingTime returns the time remaining before the current call times out.
+
+This is the earliest of the hydrate call deadline (carried by the context passed to the [HydrateFunc])
+and the query deadline. If there is no deadline, ok is false.
+
+This may be used to size page requests:
+
+	if remaining, ok := d.RemainingTime(ctx); ok && remaining < 5*time.Second {
+		input.MaxResults = aws.Int32(20)
+	}
+*/
+func (d *QueryData) RemainingTime(ctx context.Context) (remaining time.Duration, ok bool) {
+	deadline, ok := ctx.Deadline()
+	if !d.queryDeadline.IsZero() && (!ok || d.queryDeadline.Before(deadline)) {
+		deadline, ok = d.queryDeadline, true
+	}
+	if !ok {
+		return 0, false
+	}
+	return time.Until(deadline), true
+}
+
+// withTimeout returns a hydrate func which fails with a HydrateTimeoutError if the call exceeds the timeout
+//
+// NOTE: the timeout is enforced using the context deadline, so the hydrate func must respect context cancellation.
+// The call is not detached - it holds its concurrency and rate limits until it returns, and a list call
+// must not be able to continue streaming after it has returned (the stream may have been closed, or the call retried)
+func withTimeout(hydrate namedHydrateFunc, timeout time.Duration) namedHydrateFunc {
+	if timeout <= 0 {
+		return hydrate
+	}
+
+	res := hydrate.clone()
+	res.Func = func(ctx context.Context, d *QueryData, h *HydrateData) (any, error) {
+		callCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		item, err := hydrate.Func(callCtx, d, h)
+		// if the call failed because the call context timed out, return a timeout error
+		if err != nil && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+			log.Printf("[WARN] hydrate call %s timed out after %s", hydrate.Name, timeout)
+			return nil, &HydrateTimeoutError{FuncName: hydrate.Name, Timeout: timeout}
+		}
+		return item, err
+	}
+	return res
+}
+
+// if the query context exceeded the query timeout, convert the error into a QueryTimeoutError
+func toQueryTimeoutError(ctx context.Context, err error, table *Table) error {
+	if err == nil || table.QueryTimeout <= 0 || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	log.Printf("[WARN] query of table %s timed out after %s: %s", table.Name, table.QueryTimeout, err.Error())
+	return &QueryTimeoutError{Table: table.Name, Timeout: table.QueryTimeout}
+}
+
+func validateTimeout(timeout time.Duration, property string, table *Table) []string {
+	if timeout >= 0 {
+		return nil
+	}
+	if table != nil {
+		return []string{fmt.Sprintf("table '%s': %s cannot be negative", table.Name, property)}
+	}
+	return []string{fmt.Sprintf("%s cannot be negative", property)}
+}
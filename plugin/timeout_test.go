@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	tests := map[string]struct {
+		hydrate       HydrateFunc
+		expectTimeout bool
+	}{
+		"completes": {
+			hydrate: func(context.Context, *QueryData, *HydrateData) (interface{}, error) {
+				return "ok", nil
+			},
+		},
+		"respects context": {
+			hydrate: func(ctx context.Context, _ *QueryData, _ *HydrateData) (interface{}, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			expectTimeout: true,
+		},
+		"returns other error": {
+			hydrate: func(context.Context, *QueryData, *HydrateData) (interface{}, error) {
+				return nil, errors.New("failed")
+			},
+		},
+	}
+	for name, test := range tests {
+		hydrate := withTimeout(newNamedHydrateFunc(test.hydrate), 20*time.Millisecond)
+		_, err := hydrate.Func(context.Background(), nil, nil)
+		if IsHydrateTimeoutError(err) != test.expectTimeout {
+			t.Errorf("test %s: expected timeout %v, got error %v", name, test.expectTimeout, err)
+		}
+		if test.expectTimeout && !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("test %s: expected timeout error to wrap context.DeadlineExceeded", name)
+		}
+	}
+
+	// if the parent context is cancelled, the cancellation error is returned
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hydrate := withTimeout(newNamedHydrateFunc(tests["respects context"].hydrate), time.Second)
+	if _, err := hydrate.Func(ctx, nil, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context cancelled error, got %v", err)
+	}
+}
+
+func TestWithTimeoutNotDetached(t *testing.T) {
+	var returned atomic.Bool
+	hydrate := withTimeout(newNamedHydrateFunc(func(ctx context.Context, _ *QueryData, _ *HydrateData) (interface{}, error) {
+		defer returned.Store(true)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}), 20*time.Millisecond)
+
+	_, err := hydrate.Func(context.Background(), nil, nil)
+	if !IsHydrateTimeoutError(err) {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+	// the call is not detached - it must have returned before the timeout error is returned
+	// (so it does not continue to run after its concurrency and rate limits are released)
+	if !returned.Load() {
+		t.Error("expected hydrate call to have returned")
+	}
+}
+
+func TestRemainingTime(t *testing.T) {
+	d := &QueryData{}
+	if _, ok := d.RemainingTime(context.Background()); ok {
+		t.Error("expected no deadline")
+	}
+
+	// the query deadline is used if the context has no deadline
+	d.queryDeadline = time.Now().Add(time.Minute)
+	if remaining, ok := d.RemainingTime(context.Background()); !ok || remaining > time.Minute || remaining < 59*time.Second {
+		t.Errorf("expected remaining time of 1m, got %s", remaining)
+	}
+
+	// the earlier call deadline is used
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if remaining, ok := d.RemainingTime(ctx); !ok || remaining > time.Second {
+		t.Errorf("expected remaining time of at most 1s, got %s", remaining)
+	}
+}
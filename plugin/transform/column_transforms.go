@@ -2,6 +2,7 @@ package transform
 
 import (
 	"context"
+	"errors"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
 )
@@ -22,6 +23,9 @@ type TransformData struct {
 	MatrixItem map[string]interface{}
 	// KeyColumnQuals will be populated with the quals as a map of column name to an array of quals for that column
 	KeyColumnQuals map[string]quals.QualSlice
+	// an optional cache of the json representation of the row hydrate items, used by expression transforms
+	// - this is shared by all columns of the row, so each item is only converted once
+	JSONValues *JSONValueCache
 }
 
 // TransformFunc is a function to transform a data value from the api value to a column value
@@ -41,6 +45,20 @@ func (t *ColumnTransforms) Execute(ctx context.Context, transformData *Transform
 	return callTransforms(ctx, value, transformData, t.Transforms)
 }
 
+// Compile compiles any expressions used by the transforms (see [FromJSONPath], [FromJMESPath] and [FromExpr]).
+// This is called when the table is initialised - each expression is only compiled once.
+func (t *ColumnTransforms) Compile() error {
+	var errs []error
+	for _, tr := range t.Transforms {
+		if expr, ok := tr.Param.(*expression); ok {
+			if err := expr.compile(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func callTransforms(ctx context.Context, value interface{}, transformData *TransformData, transforms []*TransformCall) (interface{}, error) {
 	for _, tr := range transforms {
 		var err error
@@ -142,6 +142,51 @@ To generate a value by calling 'transformFunc', passing param.
 Examples:
   - [whois]
 
+# FromJSONPath
+
+To generate a value by evaluating a JSONPath expression against the hydrate item. The hydrate item is converted to JSON first, so fields are referenced by their json names. Each hydrate item is only converted once per row, however many columns use expressions.
+
+A definite path returns a single value. A path with wildcards or filters returns a slice of all matching values.
+
+	{
+		Name: "primary_ip",
+		Type: proto.ColumnType_IPADDR,
+		Transform: transform.FromJSONPath("$.network.interfaces[0].ip"),
+	},
+
+Examples:
+  - none
+
+# FromJMESPath
+
+To generate a value by evaluating a JMESPath expression against the hydrate item.
+
+	{
+		Name: "title",
+		Type: proto.ColumnType_STRING,
+		Transform: transform.FromJMESPath("Tags[?Key=='Name'].Value | [0]"),
+	},
+
+Examples:
+  - none
+
+# FromExpr
+
+To generate a value by evaluating a [CEL] expression. The expression may reference the variables 'item' (the hydrate item),
+'hydrate' (the results of all hydrate functions, keyed by function name), 'value' (the value from the previous transform,
+see [ColumnTransforms.TransformExpr]) and 'matrix_item'.
+
+	{
+		Name: "display_name",
+		Type: proto.ColumnType_STRING,
+		Transform: transform.FromExpr("has(item.alias) ? item.alias : hydrate['getUser'].name"),
+	},
+
+Expressions are compiled when the table is initialised, and an invalid expression causes table validation to fail.
+
+Examples:
+  - none
+
 # Chained function: Transform
 
 To apply an arbitrary transform to the data (specified by 'transformFunc').
@@ -192,6 +237,7 @@ Examples:
 [aws]: https://github.com/turbot/steampipe-plugin-aws/blob/010ec0762c273b4549b4369fe05d61ec1ce24a9b/aws/table_aws_iam_credential_report.go#L57
 [crowdstrike]: https://github.com/turbot/steampipe-plugin-crowdstrike/blob/2c02025fd83b525c8b8ed9cf98fe7540ef1209b8/crowdstrike/table_crowdstrike_detection.go
 [whois]: https://github.com/turbot/steampipe-plugin-whois/blob/f5e218cd11e04a7afa6855cf0af419ba1fd33842/whois/table_whois_domain.go#L43
+[CEL]: https://github.com/google/cel-spec
 
 [whois]: https://github.com/turbot/steampipe-plugin-whois/blob/f5e218cd11e04a7afa6855cf0af419ba1fd33842/whois/table_whois_domain.go#L26
 [aws]: https://github.com/turbot/steampipe-plugin-aws/blob/010ec0762c273b4549b4369fe05d61ec1ce24a9b/aws/table_aws_iam_credential_report.go#L81
@@ -0,0 +1,257 @@
+package transform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/jmespath/go-jmespath"
+	"github.com/ohler55/ojg/jp"
+	"github.com/turbot/go-kit/helpers"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	ExpressionLanguageJSONPath = "jsonpath"
+	ExpressionLanguageJMESPath = "jmespath"
+	ExpressionLanguageCEL      = "cel"
+)
+
+// the variables available to CEL expressions
+const (
+	// the hydrate item for the column
+	celVariableItem = "item"
+	// the results of all hydrate functions, keyed by hydrate function name
+	celVariableHydrate = "hydrate"
+	// the value passed from the previous transform in the chain
+	celVariableValue = "value"
+	// the matrix item for the row
+	celVariableMatrixItem = "matrix_item"
+)
+
+// expression is the param of an [ExpressionValue] transform.
+// The expression is compiled once, either when the table is initialised or on first use
+type expression struct {
+	language string
+	source   string
+
+	compileOnce sync.Once
+	compileErr  error
+	evaluate    func(ctx context.Context, d *TransformData) (interface{}, error)
+}
+
+func newExpression(language, source string) *expression {
+	return &expression{language: language, source: source}
+}
+
+func (e *expression) String() string {
+	return fmt.Sprintf("%s expression '%s'", e.language, e.source)
+}
+
+// compile the expression - this only compiles the expression once and returns the same error on subsequent calls
+func (e *expression) compile() error {
+	e.compileOnce.Do(func() {
+		switch e.language {
+		case ExpressionLanguageJSONPath:
+			e.evaluate, e.compileErr = compileJSONPath(e.source)
+		case ExpressionLanguageJMESPath:
+			e.evaluate, e.compileErr = compileJMESPath(e.source)
+		case ExpressionLanguageCEL:
+			e.evaluate, e.compileErr = compileCEL(e.source)
+		default:
+			e.compileErr = fmt.Errorf("unsupported expression language '%s'", e.language)
+		}
+		if e.compileErr != nil {
+			e.compileErr = fmt.Errorf("failed to compile %s: %s", e.String(), e.compileErr.Error())
+		}
+	})
+	return e.compileErr
+}
+
+func compileJSONPath(source string) (func(context.Context, *TransformData) (interface{}, error), error) {
+	path, err := jp.ParseString(source)
+	if err != nil {
+		return nil, err
+	}
+	definite := isDefiniteJSONPath(path)
+	return func(_ context.Context, d *TransformData) (interface{}, error) {
+		item, err := d.JSONValues.get(d.HydrateItem)
+		if err != nil || item == nil {
+			return nil, err
+		}
+		res := path.Get(item)
+		// a definite path (i.e. one without wildcards, filters, slices, unions or descent) returns a single value
+		if definite {
+			if len(res) == 0 {
+				return nil, nil
+			}
+			return res[0], nil
+		}
+		return res, nil
+	}, nil
+}
+
+// isDefiniteJSONPath returns whether the path can only match a single value
+func isDefiniteJSONPath(path jp.Expr) bool {
+	for _, frag := range path {
+		switch frag.(type) {
+		case jp.Root, jp.At, jp.Child, jp.Nth, jp.Bracket:
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func compileJMESPath(source string) (func(context.Context, *TransformData) (interface{}, error), error) {
+	query, err := jmespath.Compile(source)
+	if err != nil {
+		return nil, err
+	}
+	return func(_ context.Context, d *TransformData) (interface{}, error) {
+		item, err := d.JSONValues.get(d.HydrateItem)
+		if err != nil || item == nil {
+			return nil, err
+		}
+		return query.Search(item)
+	}, nil
+}
+
+func compileCEL(source string) (func(context.Context, *TransformData) (interface{}, error), error) {
+	env, err := cel.NewEnv(
+		cel.Variable(celVariableItem, cel.DynType),
+		cel.Variable(celVariableHydrate, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(celVariableValue, cel.DynType),
+		cel.Variable(celVariableMatrixItem, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := env.Program(ast, cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, err
+	}
+	// determine which variables the expression references - only these are converted when it is evaluated
+	referencedVariables := map[string]struct{}{}
+	for _, ref := range ast.NativeRep().ReferenceMap() {
+		referencedVariables[ref.Name] = struct{}{}
+	}
+	return func(ctx context.Context, d *TransformData) (interface{}, error) {
+		sources := map[string]any{
+			celVariableItem:       d.HydrateItem,
+			celVariableHydrate:    d.HydrateResults,
+			celVariableValue:      d.Value,
+			celVariableMatrixItem: d.MatrixItem,
+		}
+		vars := make(map[string]any, len(referencedVariables))
+		for name := range referencedVariables {
+			source, ok := sources[name]
+			if !ok {
+				continue
+			}
+			v, err := d.JSONValues.get(source)
+			if err != nil {
+				return nil, err
+			}
+			vars[name] = v
+		}
+		out, _, err := program.ContextEval(ctx, vars)
+		if err != nil {
+			return nil, err
+		}
+		// convert the result into a native (json) value
+		native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+		if err != nil {
+			return nil, err
+		}
+		return native.(*structpb.Value).AsInterface(), nil
+	}, nil
+}
+
+// JSONValueCache caches the generic json representation of the hydrate items of a row,
+// so that each item is only converted once, however many expression transforms reference it.
+// A JSONValueCache must only be used for a single row, and is not safe for concurrent use.
+type JSONValueCache struct {
+	values map[jsonValueKey]interface{}
+}
+
+// the identity of a cached item - this is the address of the item data,
+// together with the type and (for slices) the length, as a slice may share its address with a sub-slice
+type jsonValueKey struct {
+	itemType reflect.Type
+	ptr      uintptr
+	len      int
+}
+
+func NewJSONValueCache() *JSONValueCache {
+	return &JSONValueCache{values: make(map[jsonValueKey]interface{})}
+}
+
+// get returns the generic json representation of the given item
+// pointers, maps and slices are cached by identity - other values (and all values if the cache is nil) are converted every time
+func (c *JSONValueCache) get(item interface{}) (interface{}, error) {
+	if c == nil || helpers.IsNil(item) {
+		return toJSONValue(item)
+	}
+	var key jsonValueKey
+	switch v := reflect.ValueOf(item); v.Kind() {
+	case reflect.Pointer, reflect.Map:
+		key = jsonValueKey{itemType: v.Type(), ptr: v.Pointer()}
+	case reflect.Slice:
+		key = jsonValueKey{itemType: v.Type(), ptr: v.Pointer(), len: v.Len()}
+	default:
+		return toJSONValue(item)
+	}
+	if res, ok := c.values[key]; ok {
+		return res, nil
+	}
+	res, err := toJSONValue(item)
+	if err != nil {
+		return nil, err
+	}
+	c.values[key] = res
+	return res, nil
+}
+
+// toJSONValue converts the given item into its generic json representation
+// (i.e. map[string]interface{}, []interface{}, string, float64, bool or nil)
+// so that struct fields may be referenced using their json names
+func toJSONValue(item interface{}) (interface{}, error) {
+	if helpers.IsNil(item) {
+		return nil, nil
+	}
+	jsonBytes, err := json.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T to json: %s", item, err.Error())
+	}
+	var res interface{}
+	if err := json.Unmarshal(jsonBytes, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExpressionValue is intended for the start of a transform chain.
+// This evaluates the JSONPath, JMESPath or CEL expression passed as the param.
+func ExpressionValue(ctx context.Context, d *TransformData) (interface{}, error) {
+	expr, ok := d.Param.(*expression)
+	if !ok {
+		return nil, fmt.Errorf("'ExpressionValue' requires an expression parameter, created using FromJSONPath, FromJMESPath or FromExpr, but received %v", d.Param)
+	}
+	if err := expr.compile(); err != nil {
+		return nil, err
+	}
+	res, err := expr.evaluate(ctx, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate %s for column %s: %s", expr.String(), d.ColumnName, err.Error())
+	}
+	return res, nil
+}
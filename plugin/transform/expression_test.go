@@ -0,0 +1,188 @@
+package transform
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type exprTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type exprItem struct {
+	Name  string    `json:"name"`
+	Size  int       `json:"size"`
+	Tags  []exprTag `json:"tags"`
+	Owner *exprItem `json:"owner,omitempty"`
+}
+
+var exprTestItem = &exprItem{
+	Name: "bucket-a",
+	Size: 10,
+	Tags: []exprTag{{Key: "env", Value: "prod"}, {Key: "Name", Value: "A"}},
+}
+
+var testCasesExpressionTransform = map[string]ColumnDataTransformTest{
+	"FromJSONPath (definite path)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromJSONPath("$.tags[1].value"),
+		expected:      "A",
+	},
+	"FromJSONPath (missing property)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromJSONPath("$.owner.name"),
+		expected:      nil,
+	},
+	"FromJSONPath (filter)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromJSONPath("$.tags[?(@.key == 'Name')].value"),
+		expected:      []interface{}{"A"},
+	},
+	"FromJSONPath (nil hydrate item)": {
+		transformData: &TransformData{},
+		transforms:    FromJSONPath("$.name"),
+		expected:      nil,
+	},
+	"FromJMESPath (first matching tag)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromJMESPath("tags[?key=='Name'].value | [0]"),
+		expected:      "A",
+	},
+	"FromJMESPath (projection)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromJMESPath("tags[*].key"),
+		expected:      []interface{}{"env", "Name"},
+	},
+	"FromExpr (item)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromExpr("item.size > 5 ? item.name : 'small'"),
+		expected:      "bucket-a",
+	},
+	"FromExpr (filter)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromExpr("item.tags.filter(t, t.key == 'env').map(t, t.value)"),
+		expected:      []interface{}{"prod"},
+	},
+	"FromExpr (hydrate results and matrix item)": {
+		transformData: &TransformData{
+			HydrateItem:    exprTestItem,
+			HydrateResults: map[string]interface{}{"getOwner": &exprItem{Name: "owner-a"}},
+		},
+		transforms: FromExpr("hydrate['getOwner'].name + '/' + item.name"),
+		expected:   "owner-a/bucket-a",
+	},
+	"TransformExpr (chained value)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromField("Name").TransformExpr("size(value)"),
+		expected:      float64(8),
+	},
+	"ExpressionValue (invalid param)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromField("Name").Transform(ExpressionValue),
+		expected:      "ERROR",
+	},
+	"FromExpr (evaluation error)": {
+		transformData: &TransformData{HydrateItem: exprTestItem},
+		transforms:    FromExpr("item.missing"),
+		expected:      "ERROR",
+	},
+	"FromExpr (item cannot be converted)": {
+		transformData: &TransformData{HydrateItem: map[string]interface{}{"c": make(chan int)}},
+		transforms:    FromExpr("item.c"),
+		expected:      "ERROR",
+	},
+	"FromExpr (unreferenced hydrate result cannot be converted)": {
+		transformData: &TransformData{
+			HydrateItem:    exprTestItem,
+			HydrateResults: map[string]interface{}{"getChan": make(chan int)},
+		},
+		transforms: FromExpr("item.name"),
+		expected:   "bucket-a",
+	},
+}
+
+func TestExpressionTransform(t *testing.T) {
+	for name, test := range testCasesExpressionTransform {
+		result, err := test.transforms.Execute(textCtx, test.transformData)
+		if err != nil {
+			if test.expected != "ERROR" {
+				t.Errorf("Test: '%s'' FAILED : \nunexpected error %v", name, err)
+			}
+			continue
+		}
+		if test.expected == "ERROR" {
+			t.Errorf("Test: '%s'' FAILED : \nexpected error, got %v", name, result)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, result) {
+			t.Errorf("Test: '%s'' FAILED : \nexpected:\n %#v, \n\ngot:\n %#v", name, test.expected, result)
+		}
+	}
+}
+
+func TestCompileExpressions(t *testing.T) {
+	testCases := map[string]struct {
+		transforms  *ColumnTransforms
+		expectError bool
+	}{
+		"valid JSONPath":          {transforms: FromJSONPath("$.tags[0].key")},
+		"invalid JSONPath":        {transforms: FromJSONPath("$.tags[0"), expectError: true},
+		"valid JMESPath":          {transforms: FromJMESPath("tags[0].key")},
+		"invalid JMESPath":        {transforms: FromJMESPath("tags[?"), expectError: true},
+		"valid CEL":               {transforms: FromExpr("item.name + '-' + matrix_item.region")},
+		"invalid CEL":             {transforms: FromExpr("item.name +"), expectError: true},
+		"undeclared CEL variable": {transforms: FromExpr("row.name"), expectError: true},
+		"no expressions":          {transforms: FromField("Name")},
+	}
+	for name, test := range testCases {
+		err := test.transforms.Compile()
+		if test.expectError != (err != nil) {
+			t.Errorf("test %s: expected error %v, got %v", name, test.expectError, err)
+		}
+		// compiling again returns the same result
+		if err2 := test.transforms.Compile(); (err == nil) != (err2 == nil) {
+			t.Errorf("test %s: expected repeated compile to return the same result", name)
+		}
+	}
+}
+
+// countingItem counts the number of times it is converted to json
+type countingItem struct {
+	Name  string
+	count *int
+}
+
+func (i *countingItem) MarshalJSON() ([]byte, error) {
+	*i.count++
+	return json.Marshal(map[string]string{"name": i.Name})
+}
+
+func TestJSONValueCache(t *testing.T) {
+	var count int
+	item := &countingItem{Name: "a", count: &count}
+	hydrateResults := map[string]interface{}{"getItem": item}
+	jsonValues := NewJSONValueCache()
+
+	columns := []*ColumnTransforms{
+		FromJSONPath("$.name"),
+		FromJMESPath("name"),
+		FromExpr("item.name"),
+		FromExpr("hydrate['getItem'].name"),
+	}
+	for _, transforms := range columns {
+		d := &TransformData{HydrateItem: item, HydrateResults: hydrateResults, JSONValues: jsonValues}
+		result, err := transforms.Execute(textCtx, d)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if result != "a" {
+			t.Errorf("expected 'a', got %v", result)
+		}
+	}
+	// the item is converted once for the item itself, and once as part of the hydrate results
+	if count != 2 {
+		t.Errorf("expected the item to be converted 2 times, got %d", count)
+	}
+}
@@ -0,0 +1,256 @@
+package transform
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/iancoleman/strcase"
+	"github.com/turbot/go-kit/helpers"
+)
+
+// Field accessors are used by the FieldValue transforms to retrieve field values from hydrate items.
+//
+// Resolving a property path using reflection for every column of every row is expensive,
+// so the parsed property paths, and the struct field indexes for each (type, field name),
+// are compiled on first use and cached.
+//
+// The accessors have the same semantics as helpers.GetNestedFieldValueFromInterface:
+//   - a property path is a dot separated list of field names
+//   - a field name may have a single array index suffix, e.g. 'Tags[0]'
+//   - fields are resolved from structs (or pointers to structs), including fields of embedded structs
+//   - fields are resolved from maps using the field name as a string key
+//   - unexported fields cannot be read
+
+var (
+	// map of property path to *fieldPath
+	fieldPathCache sync.Map
+	// map of structFieldKey to *structFieldAccessor
+	structFieldCache sync.Map
+	// map of column name to camel case property path
+	camelCasePathCache sync.Map
+	// map of column name to go property path
+	goPathCache sync.Map
+	// map of tagFieldKey to the name of the field with a matching tag
+	tagFieldCache sync.Map
+
+	stringType = reflect.TypeOf("")
+)
+
+// fieldPath is a parsed property path
+type fieldPath []fieldPathSegment
+
+// fieldPathSegment is a single segment of a property path, e.g. 'Name' or 'Tags[0]'
+type fieldPathSegment struct {
+	name string
+	// if this is an array segment, the index into the array
+	index   int
+	isArray bool
+}
+
+type structFieldKey struct {
+	structType reflect.Type
+	name       string
+}
+
+// structFieldAccessor is the compiled accessor for a field of a struct type
+type structFieldAccessor struct {
+	index []int
+	// is the field valid and exported
+	ok bool
+}
+
+type tagFieldKey struct {
+	structType reflect.Type
+	tagName    string
+	columnName string
+}
+
+// getNestedFieldValue returns the value of the given nested property path of the item
+func getNestedFieldValue(item interface{}, propertyPath string) (interface{}, bool) {
+	value := item
+	for _, segment := range getFieldPath(propertyPath) {
+		var ok bool
+		value, ok = segment.getValue(value)
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// getFieldPath returns the parsed property path, parsing and caching it if needed
+func getFieldPath(propertyPath string) fieldPath {
+	if cached, ok := fieldPathCache.Load(propertyPath); ok {
+		return cached.(fieldPath)
+	}
+	pathSegments := strings.Split(propertyPath, ".")
+	res := make(fieldPath, len(pathSegments))
+	for i, s := range pathSegments {
+		// if there are any dots encoded in this segment, decode
+		res[i] = newFieldPathSegment(helpers.UnescapePropertyName(s))
+	}
+	fieldPathCache.Store(propertyPath, res)
+	return res
+}
+
+func newFieldPathSegment(fieldName string) fieldPathSegment {
+	// is this an array segment, e.g. 'Tags[0]'
+	// NOTE: multi-level arrays, e.g. 'Tags[0][1]', are not supported
+	if strings.HasSuffix(fieldName, "]") {
+		if openIdx := strings.LastIndex(fieldName, "["); openIdx != -1 {
+			name := fieldName[:openIdx]
+			digits := fieldName[openIdx+1 : len(fieldName)-1]
+			if index, err := strconv.Atoi(digits); err == nil && isDigits(digits) && !newFieldPathSegment(name).isArray {
+				return fieldPathSegment{name: name, index: index, isArray: true}
+			}
+		}
+	}
+	return fieldPathSegment{name: fieldName}
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+func (s fieldPathSegment) getValue(item interface{}) (interface{}, bool) {
+	value, ok := getFieldValue(item, s.name)
+	if !ok || !s.isArray {
+		return value, ok
+	}
+	return getSliceValue(value, s.index)
+}
+
+// getFieldValue returns the value of the named field of a struct, pointer to a struct or map
+func getFieldValue(item interface{}, fieldName string) (interface{}, bool) {
+	if item == nil {
+		return nil, false
+	}
+	v := reflect.ValueOf(item)
+	switch v.Kind() {
+	case reflect.Map:
+		if !stringType.AssignableTo(v.Type().Key()) {
+			return nil, false
+		}
+		mapValue := v.MapIndex(reflect.ValueOf(fieldName))
+		if !mapValue.IsValid() {
+			return nil, false
+		}
+		return mapValue.Interface(), true
+	case reflect.Pointer:
+		if v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return nil, false
+		}
+		v = v.Elem()
+	case reflect.Struct:
+	default:
+		return nil, false
+	}
+
+	accessor := getStructFieldAccessor(v.Type(), fieldName)
+	if !accessor.ok {
+		return nil, false
+	}
+	fieldValue, err := v.FieldByIndexErr(accessor.index)
+	if err != nil || !fieldValue.CanInterface() {
+		return nil, false
+	}
+	return fieldValue.Interface(), true
+}
+
+// getStructFieldAccessor returns the compiled accessor for the named field of the struct type,
+// compiling and caching it if needed
+func getStructFieldAccessor(structType reflect.Type, fieldName string) *structFieldAccessor {
+	key := structFieldKey{structType: structType, name: fieldName}
+	if cached, ok := structFieldCache.Load(key); ok {
+		return cached.(*structFieldAccessor)
+	}
+	res := &structFieldAccessor{}
+	// only fields declared by the struct, or by embedded (non pointer) structs are resolved
+	if structHasField(structType, fieldName) {
+		if field, found := structType.FieldByName(fieldName); found && field.IsExported() {
+			res.index = field.Index
+			res.ok = true
+		}
+	}
+	structFieldCache.Store(key, res)
+	return res
+}
+
+// structHasField returns whether the struct type, or any embedded (non pointer) struct, declares the field
+func structHasField(structType reflect.Type, fieldName string) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.Name == fieldName {
+			return true
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && structHasField(field.Type, fieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSliceValue returns the element of the slice at the given index
+func getSliceValue(item interface{}, index int) (interface{}, bool) {
+	if item == nil {
+		return nil, false
+	}
+	v := reflect.ValueOf(item)
+	if v.Kind() != reflect.Slice || index >= v.Len() {
+		return nil, false
+	}
+	return v.Index(index).Interface(), true
+}
+
+// getCamelCasePropertyPath returns the camel case property path for the column name
+func getCamelCasePropertyPath(columnName string) string {
+	if cached, ok := camelCasePathCache.Load(columnName); ok {
+		return cached.(string)
+	}
+	res := strcase.ToCamel(columnName)
+	camelCasePathCache.Store(columnName, res)
+	return res
+}
+
+// getGoPropertyPath returns the camel case property path for the column name, with common initialisms upper case
+func getGoPropertyPath(columnName string) string {
+	if cached, ok := goPathCache.Load(columnName); ok {
+		return cached.(string)
+	}
+	// call lintName to make common initialisms upper case
+	res := helpers.LintName(strcase.ToCamel(columnName))
+	goPathCache.Store(columnName, res)
+	return res
+}
+
+// getTagFieldName returns the name of the field of the struct type which has a tag 'tagName' matching the column name
+func getTagFieldName(structType reflect.Type, tagName, columnName string) (string, bool) {
+	key := tagFieldKey{structType: structType, tagName: tagName, columnName: columnName}
+	if cached, ok := tagFieldCache.Load(key); ok {
+		fieldName := cached.(string)
+		return fieldName, fieldName != ""
+	}
+	var res string
+	// iterate over all available fields and read the tag value
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get(tagName)
+		if tag == "" {
+			continue
+		}
+		// get the first segment of the tag
+		if tagField := strings.Split(tag, ",")[0]; tagField == columnName {
+			res = field.Name
+			break
+		}
+	}
+	tagFieldCache.Store(key, res)
+	return res, res != ""
+}
@@ -0,0 +1,160 @@
+package transform
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/turbot/go-kit/helpers"
+)
+
+type accessorEmbedded struct {
+	EmbeddedName string
+}
+
+type accessorNested struct {
+	Value string
+	Items []string
+}
+
+type accessorItem struct {
+	accessorEmbedded
+	Name      string            `json:"name"`
+	Count     int               `json:"count"`
+	Nested    *accessorNested   `json:"nested"`
+	NilNested *accessorNested   `json:"nil_nested"`
+	Tags      map[string]string `json:"tags"`
+	List      []accessorNested  `json:"list"`
+	Any       interface{}       `json:"any"`
+	private   string
+}
+
+var accessorTestItem = &accessorItem{
+	accessorEmbedded: accessorEmbedded{EmbeddedName: "embedded"},
+	Name:             "item",
+	Count:            3,
+	Nested:           &accessorNested{Value: "nested", Items: []string{"a", "b"}},
+	Tags:             map[string]string{"env": "prod", "dotted.key": "dotted"},
+	List:             []accessorNested{{Value: "first"}, {Value: "second"}},
+	Any:              map[string]interface{}{"inner": []interface{}{"x", "y"}},
+	private:          "private",
+}
+
+// the accessor must return the same results as helpers.GetNestedFieldValueFromInterface
+func TestGetNestedFieldValueMatchesReflection(t *testing.T) {
+	paths := []string{
+		"Name",
+		"Count",
+		"EmbeddedName",
+		"Nested.Value",
+		"Nested.Items[1]",
+		"Nested.Items[2]",
+		"NilNested.Value",
+		"Tags.env",
+		"Tags.missing",
+		"Tags." + helpers.EscapePropertyName("dotted.key"),
+		"List[0].Value",
+		"List[1].Value",
+		"List[5].Value",
+		"Any.inner[1]",
+		"private",
+		"Missing",
+		"Name.Missing",
+		"List[0][0]",
+	}
+	for _, item := range []interface{}{accessorTestItem, *accessorTestItem} {
+		for _, path := range paths {
+			expected, expectedOk := helpers.GetNestedFieldValueFromInterface(item, path)
+			// call twice to exercise the cached path
+			for i := 0; i < 2; i++ {
+				actual, ok := getNestedFieldValue(item, path)
+				if ok != expectedOk || !reflect.DeepEqual(actual, expected) {
+					t.Errorf("%T path %s: expected %v (%v), got %v (%v)", item, path, expected, expectedOk, actual, ok)
+				}
+			}
+		}
+	}
+}
+
+func TestGetNestedFieldValueNilIntermediate(t *testing.T) {
+	item := map[string]interface{}{"a": nil}
+	if value, ok := getNestedFieldValue(item, "a.b"); ok || value != nil {
+		t.Errorf("expected no value for a nil intermediate value, got %v", value)
+	}
+}
+
+func TestNewFieldPathSegment(t *testing.T) {
+	testCases := map[string]fieldPathSegment{
+		"Name":       {name: "Name"},
+		"Tags[3]":    {name: "Tags", index: 3, isArray: true},
+		"Tags[x]":    {name: "Tags[x]"},
+		"Tags[1][2]": {name: "Tags[1][2]"},
+		"[0]":        {name: "", index: 0, isArray: true},
+		"Tags[-1]":   {name: "Tags[-1]"},
+	}
+	for fieldName, expected := range testCases {
+		if actual := newFieldPathSegment(fieldName); actual != expected {
+			t.Errorf("field name %s: expected %+v, got %+v", fieldName, expected, actual)
+		}
+	}
+}
+
+// reflectionFieldValue is the FieldValue implementation which resolves the property path using reflection for every call
+// - used to benchmark the compiled accessors
+func reflectionFieldValue(_ context.Context, d *TransformData) (interface{}, error) {
+	value, _ := helpers.GetNestedFieldValueFromInterface(d.HydrateItem, d.Param.(string))
+	return value, nil
+}
+
+func benchmarkTransform(b *testing.B, transformFunc TransformFunc, param interface{}, columnName string) {
+	ctx := context.Background()
+	d := &TransformData{HydrateItem: accessorTestItem, ColumnName: columnName}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Param = param
+		if _, err := transformFunc(ctx, d); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFieldValue(b *testing.B) {
+	benchmarkTransform(b, FieldValue, "Name", "name")
+}
+
+func BenchmarkFieldValueReflection(b *testing.B) {
+	benchmarkTransform(b, reflectionFieldValue, "Name", "name")
+}
+
+func BenchmarkFieldValueNested(b *testing.B) {
+	benchmarkTransform(b, FieldValue, "Nested.Items[1]", "item")
+}
+
+func BenchmarkFieldValueNestedReflection(b *testing.B) {
+	benchmarkTransform(b, reflectionFieldValue, "Nested.Items[1]", "item")
+}
+
+func BenchmarkFieldValueGo(b *testing.B) {
+	benchmarkTransform(b, FieldValueGo, nil, "embedded_name")
+}
+
+func BenchmarkFieldValueTag(b *testing.B) {
+	benchmarkTransform(b, FieldValueTag, "json", "count")
+}
+
+// benchmark building all columns of a wide table row
+func BenchmarkColumnTransformsWideRow(b *testing.B) {
+	columns := []string{"name", "count", "nested", "nil_nested", "tags", "list", "any"}
+	transforms := FromJSONTag()
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, c := range columns {
+			if _, err := transforms.Execute(ctx, &TransformData{HydrateItem: accessorTestItem, ColumnName: c}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
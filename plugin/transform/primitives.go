@@ -12,7 +12,6 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
 
 	"github.com/ghodss/yaml"
-	"github.com/iancoleman/strcase"
 	"github.com/turbot/go-kit/helpers"
 	"github.com/turbot/go-kit/types"
 )
@@ -44,7 +43,7 @@ func FieldValue(_ context.Context, d *TransformData) (interface{}, error) {
 	// - this is so that any casting code in the plugin still works
 	var fieldValue interface{} = nil
 	for _, propertyPath := range fieldNames {
-		fieldValue, _ = helpers.GetNestedFieldValueFromInterface(item, propertyPath)
+		fieldValue, _ = getNestedFieldValue(item, propertyPath)
 		if !helpers.IsNil(fieldValue) {
 			break
 		}
@@ -56,7 +55,7 @@ func FieldValue(_ context.Context, d *TransformData) (interface{}, error) {
 // FieldValueCamelCase is intended for the start of a transform chain
 // This converts the column name to camel case and call FieldValue
 func FieldValueCamelCase(ctx context.Context, d *TransformData) (interface{}, error) {
-	propertyPath := strcase.ToCamel(d.ColumnName)
+	propertyPath := getCamelCasePropertyPath(d.ColumnName)
 	if propertyPath == "" {
 		return nil, fmt.Errorf("'FieldValue' requires a string parameter containing property path but received %v", d.Param)
 	}
@@ -68,8 +67,8 @@ func FieldValueCamelCase(ctx context.Context, d *TransformData) (interface{}, er
 // FieldValueGo is intended for the start of a transform chain
 // This converts the column name to camel case, with common initialisms upper case, and call FieldValue
 func FieldValueGo(ctx context.Context, d *TransformData) (interface{}, error) {
-	// call lintName to make common initialisms upper case
-	propertyPath := helpers.LintName(strcase.ToCamel(d.ColumnName))
+	// NOTE: common initialisms are upper case
+	propertyPath := getGoPropertyPath(d.ColumnName)
 	if propertyPath == "" {
 		return nil, fmt.Errorf("'FieldValue' requires a string parameter containing property path but received %v", d.Param)
 	}
@@ -98,23 +97,16 @@ func FieldValueTag(ctx context.Context, d *TransformData) (interface{}, error) {
 	// If variable is a nil interface value, TypeOf returns nil.
 	item := helpers.DereferencePointer(d.HydrateItem)
 	t := reflect.TypeOf(item)
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("'FieldValueTag' requires a struct hydrate item but received %T", d.HydrateItem)
+	}
 
-	// Iterate over all available fields and read the tag value
-	for i := 0; i < t.NumField(); i++ {
-		// Get the field, returns https://golang.org/pkg/reflect/#StructField
-		field := t.Field(i)
-		// Get the field tag value
-		tag := field.Tag.Get(tagName)
-		if tag == "" {
-			continue
-		}
-		// get the first segment of the tag
-		tagField := strings.Split(tag, ",")[0]
-		if tagField == d.ColumnName {
-			// mutate transform data to set the param to the field name and call FieldValue
-			d.Param = field.Name
-			return FieldValue(ctx, d)
-		}
+	// find the field with a tag matching the column name
+	// (the field name is cached for each type, tag name and column)
+	if fieldName, ok := getTagFieldName(t, tagName, d.ColumnName); ok {
+		// mutate transform data to set the param to the field name and call FieldValue
+		d.Param = fieldName
+		return FieldValue(ctx, d)
 	}
 	return nil, fmt.Errorf("'FieldValueTag' - no property found with tag matching column %s", d.ColumnName)
 
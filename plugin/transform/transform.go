@@ -68,6 +68,24 @@ func FromTag(tagName string) *ColumnTransforms {
 	return &ColumnTransforms{Transforms: []*TransformCall{{Transform: FieldValueTag, Param: tagName}}}
 }
 
+// FromJSONPath generates a value by evaluating a JSONPath expression against the hydrate item, e.g. "$.network.interfaces[0].ip"
+// A definite path returns a single value (nil if there is no match), otherwise a slice of all matching values is returned
+func FromJSONPath(expr string) *ColumnTransforms {
+	return &ColumnTransforms{Transforms: []*TransformCall{{Transform: ExpressionValue, Param: newExpression(ExpressionLanguageJSONPath, expr)}}}
+}
+
+// FromJMESPath generates a value by evaluating a JMESPath expression against the hydrate item, e.g. "Tags[?Key=='Name'].Value | [0]"
+func FromJMESPath(expr string) *ColumnTransforms {
+	return &ColumnTransforms{Transforms: []*TransformCall{{Transform: ExpressionValue, Param: newExpression(ExpressionLanguageJMESPath, expr)}}}
+}
+
+// FromExpr generates a value by evaluating a CEL expression, e.g. "item.size > 0 ? item.name : hydrate['getDetails'].name"
+// The expression may reference the variables 'item' (the hydrate item), 'hydrate' (all hydrate results, keyed by function name),
+// 'value' (the value from the previous transform) and 'matrix_item'
+func FromExpr(expr string) *ColumnTransforms {
+	return &ColumnTransforms{Transforms: []*TransformCall{{Transform: ExpressionValue, Param: newExpression(ExpressionLanguageCEL, expr)}}}
+}
+
 //  TRANSFORM functions
 // these can be chained after a From function to transform the data
 
@@ -84,6 +102,12 @@ func (t *ColumnTransforms) TransformP(transformFunc TransformFunc, param interfa
 	return t
 }
 
+// TransformExpr function applies a CEL expression to the data, which is available to the expression as the variable 'value' (see [FromExpr])
+func (t *ColumnTransforms) TransformExpr(expr string) *ColumnTransforms {
+	t.Transforms = append(t.Transforms, &TransformCall{Transform: ExpressionValue, Param: newExpression(ExpressionLanguageCEL, expr)})
+	return t
+}
+
 // NullIfEqual returns nil if the input Value equals the transform param
 func (t *ColumnTransforms) NullIfEqual(nullValue interface{}) *ColumnTransforms {
 	t.Transforms = append(t.Transforms, &TransformCall{Transform: NullIfEqualParam, Param: nullValue})
@@ -14,9 +14,11 @@ const (
 	envMaxConcurrentRows     = "STEAMPIPE_MAX_CONCURRENT_ROWS"
 )
 
+// GetMaxConcurrentRows returns the maximum number of rows which are built concurrently
+// - if STEAMPIPE_MAX_CONCURRENT_ROWS is set to zero, the number of concurrent rows is unlimited
 func GetMaxConcurrentRows() int {
 	if envStr, ok := os.LookupEnv(envMaxConcurrentRows); ok {
-		if b, err := strconv.Atoi(envStr); err == nil {
+		if b, err := strconv.Atoi(envStr); err == nil && b >= 0 {
 			return b
 		}
 	}
@@ -1,6 +1,7 @@
 package rate_limiter
 
 import (
+	"context"
 	"fmt"
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/time/rate"
@@ -53,6 +54,13 @@ func (l *HydrateLimiter) tryToAcquireSemaphore() bool {
 	return l.sem.TryAcquire(1)
 }
 
+func (l *HydrateLimiter) acquireSemaphore(ctx context.Context) error {
+	if l.sem == nil {
+		return nil
+	}
+	return l.sem.Acquire(ctx, 1)
+}
+
 func (l *HydrateLimiter) releaseSemaphore() {
 	if l.sem == nil {
 		return
@@ -61,6 +69,11 @@ func (l *HydrateLimiter) releaseSemaphore() {
 
 }
 
+// key uniquely identifies the limiter instance - used to order semaphore acquisition
+func (l *HydrateLimiter) key() string {
+	return fmt.Sprintf("%s:%s", l.Name, FormatStringMap(l.scopeValues))
+}
+
 func (l *HydrateLimiter) reserve() *rate.Reservation {
 	if l.limiter != nil {
 		return l.limiter.Reserve()
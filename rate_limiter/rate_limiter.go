@@ -6,6 +6,7 @@ import (
 	"github.com/turbot/go-kit/helpers"
 	"golang.org/x/time/rate"
 	"log"
+	"sort"
 	"strings"
 	"time"
 )
@@ -113,6 +114,30 @@ func (m *MultiLimiter) TryToAcquireSemaphore() bool {
 	return true
 }
 
+// AcquireSemaphore blocks until the semaphores of all limiters have been acquired, or the context is done
+// NOTE: the semaphores are acquired in a fixed order, so that concurrent callers cannot deadlock
+func (m *MultiLimiter) AcquireSemaphore(ctx context.Context) error {
+	limiters := make([]*HydrateLimiter, len(m.Limiters))
+	copy(limiters, m.Limiters)
+	sort.Slice(limiters, func(i, j int) bool {
+		return limiters[i].key() < limiters[j].key()
+	})
+
+	// keep track of limiters whose semaphore we have acquired
+	var acquired []*HydrateLimiter
+	for _, l := range limiters {
+		if err := l.acquireSemaphore(ctx); err != nil {
+			// we must release all acquired semaphores
+			for _, a := range acquired {
+				a.releaseSemaphore()
+			}
+			return err
+		}
+		acquired = append(acquired, l)
+	}
+	return nil
+}
+
 func (m *MultiLimiter) ReleaseSemaphore() {
 	for _, l := range m.Limiters {
 		l.releaseSemaphore()
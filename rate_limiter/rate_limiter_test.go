@@ -1,7 +1,10 @@
 package rate_limiter
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestRateLimiter(t *testing.T) {
@@ -30,3 +33,25 @@ func TestRateLimiter(t *testing.T) {
 	//
 	//wg.Wait()
 }
+
+func TestMultiLimiterAcquireSemaphore(t *testing.T) {
+	limiter := NewMultiLimiter([]*HydrateLimiter{
+		newLimiter(&Definition{Name: "l1", MaxConcurrency: 1}, nil),
+		newLimiter(&Definition{Name: "l2", MaxConcurrency: 2}, nil),
+	}, nil)
+
+	if err := limiter.AcquireSemaphore(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// l1 is now at its limit - a second acquire blocks until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.AcquireSemaphore(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	// the failed acquire must have released the l2 semaphore
+	limiter.ReleaseSemaphore()
+	if !limiter.TryToAcquireSemaphore() || !limiter.Limiters[1].tryToAcquireSemaphore() {
+		t.Error("expected semaphores to be available")
+	}
+}
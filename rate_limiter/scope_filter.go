@@ -35,6 +35,17 @@ func newScopeFilter(raw string) (*scopeFilter, error) {
 
 }
 
+// ParseScopeFilter parses a filter using the filter grammar, and returns a function which
+// evaluates whether a map of string values satisfies the filter
+// (this is used for rate limiter 'where' clauses, and for connection config error rules)
+func ParseScopeFilter(raw string) (func(values map[string]string) bool, error) {
+	f, err := newScopeFilter(raw)
+	if err != nil {
+		return nil, err
+	}
+	return f.satisfied, nil
+}
+
 func (f *scopeFilter) satisfied(values map[string]string) bool {
 	res, _ := scopeFilterSatisfied(f.filter, values)
 	return res
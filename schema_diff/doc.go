@@ -0,0 +1,14 @@
+/*
+Package schema_diff compares two versions of a plugin schema and classifies the changes between them.
+
+A plugin schema may be dumped to JSON (see [WriteSchema] and [plugin.GetPluginSchema]) and stored with the plugin source.
+[Diff] compares a stored schema with the current schema and returns a [Report] of the changes,
+identifying those which may break existing queries:
+  - a table or column is removed
+  - the type of a column changes
+  - a key column becomes required (or the any_of key columns no longer include a previously usable column)
+
+Non-breaking changes, such as added tables and columns, changed hydrate functions and relaxed key column requirements,
+are also included in the report.
+*/
+package schema_diff
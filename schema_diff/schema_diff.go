@@ -0,0 +1,354 @@
+package schema_diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"golang.org/x/exp/maps"
+)
+
+// ChangeType is the classification of a schema change
+type ChangeType string
+
+const (
+	TableAdded                ChangeType = "table_added"
+	TableRemoved              ChangeType = "table_removed"
+	TableDeprecated           ChangeType = "table_deprecated"
+	ColumnAdded               ChangeType = "column_added"
+	ColumnRemoved             ChangeType = "column_removed"
+	ColumnTypeChanged         ChangeType = "column_type_changed"
+	ColumnHydrateChanged      ChangeType = "column_hydrate_changed"
+	ColumnDeprecated          ChangeType = "column_deprecated"
+	KeyColumnAdded            ChangeType = "key_column_added"
+	KeyColumnRemoved          ChangeType = "key_column_removed"
+	KeyColumnRequireChanged   ChangeType = "key_column_require_changed"
+	KeyColumnOperatorsChanged ChangeType = "key_column_operators_changed"
+	AnyOfKeyColumnsChanged    ChangeType = "any_of_key_columns_changed"
+)
+
+// the key column requirements (these match the values of plugin.Required, plugin.Optional and plugin.AnyOf)
+const (
+	requireRequired = "required"
+	requireOptional = "optional"
+	requireAnyOf    = "any_of"
+)
+
+// Change is a single difference between two plugin schemas
+type Change struct {
+	Type  ChangeType
+	Table string
+	// the column or key column name (empty for table changes)
+	Column string
+	// for key column changes, the call the key column applies to ("get" or "list")
+	Call string
+	// the old and new values, for changed properties
+	Old string
+	New string
+	// does this change potentially break existing queries
+	Breaking bool
+}
+
+func (c Change) String() string {
+	subject := fmt.Sprintf("table '%s'", c.Table)
+	if c.Call != "" {
+		subject += " " + c.Call
+	}
+	if c.Column != "" {
+		if c.Call != "" {
+			subject += fmt.Sprintf(" key column '%s'", c.Column)
+		} else {
+			subject += fmt.Sprintf(" column '%s'", c.Column)
+		}
+	}
+
+	switch c.Type {
+	case TableAdded, ColumnAdded:
+		return subject + " was added"
+	case KeyColumnAdded:
+		return fmt.Sprintf("%s was added (%s)", subject, c.New)
+	case TableRemoved, ColumnRemoved, KeyColumnRemoved:
+		return subject + " was removed"
+	case TableDeprecated, ColumnDeprecated:
+		return subject + " was deprecated"
+	case ColumnTypeChanged:
+		return fmt.Sprintf("%s type changed from %s to %s", subject, c.Old, c.New)
+	case ColumnHydrateChanged:
+		return fmt.Sprintf("%s hydrate function changed from '%s' to '%s'", subject, c.Old, c.New)
+	case KeyColumnRequireChanged:
+		return fmt.Sprintf("%s require changed from '%s' to '%s'", subject, c.Old, c.New)
+	case KeyColumnOperatorsChanged:
+		return fmt.Sprintf("%s operators changed from [%s] to [%s]", subject, c.Old, c.New)
+	case AnyOfKeyColumnsChanged:
+		return fmt.Sprintf("%s any_of key columns changed from [%s] to [%s]", subject, c.Old, c.New)
+	}
+	return fmt.Sprintf("%s: %s", subject, c.Type)
+}
+
+// Report is the result of comparing two plugin schemas
+type Report struct {
+	Changes []Change
+}
+
+// BreakingChanges returns the changes which potentially break existing queries
+func (r *Report) BreakingChanges() []Change {
+	var res []Change
+	for _, c := range r.Changes {
+		if c.Breaking {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (r *Report) HasBreakingChanges() bool {
+	return len(r.BreakingChanges()) > 0
+}
+
+// Err returns an error listing the breaking changes, or nil if there are none
+//
+// This may be used as a test assertion, to verify a plugin schema is compatible with a previous version.
+func (r *Report) Err() error {
+	breakingChanges := r.BreakingChanges()
+	if len(breakingChanges) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "schema has %d breaking %s:", len(breakingChanges), pluralize("change", len(breakingChanges)))
+	for _, c := range breakingChanges {
+		fmt.Fprintf(&sb, "\n  - %s", c.String())
+	}
+	return fmt.Errorf("%s", sb.String())
+}
+
+func (r *Report) String() string {
+	if len(r.Changes) == 0 {
+		return "no schema changes"
+	}
+	var sb strings.Builder
+	for _, c := range r.Changes {
+		if c.Breaking {
+			sb.WriteString("BREAKING ")
+		}
+		sb.WriteString(c.String())
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "%d %s, %d breaking", len(r.Changes), pluralize("change", len(r.Changes)), len(r.BreakingChanges()))
+	return sb.String()
+}
+
+// Diff compares the old and new plugin schemas and returns a report of the changes
+//
+// The changes are ordered by table name, then column changes (in schema order), then key column changes.
+func Diff(old, new *proto.Schema) *Report {
+	res := &Report{}
+	oldTables := old.GetSchema()
+	newTables := new.GetSchema()
+
+	tableNames := maps.Keys(oldTables)
+	for name := range newTables {
+		if _, ok := oldTables[name]; !ok {
+			tableNames = append(tableNames, name)
+		}
+	}
+	slices.Sort(tableNames)
+
+	for _, tableName := range tableNames {
+		oldTable, inOld := oldTables[tableName]
+		newTable, inNew := newTables[tableName]
+		switch {
+		case !inOld:
+			res.add(Change{Type: TableAdded, Table: tableName})
+		case !inNew:
+			res.add(Change{Type: TableRemoved, Table: tableName, Breaking: true})
+		default:
+			res.diffTable(tableName, oldTable, newTable)
+		}
+	}
+	return res
+}
+
+func (r *Report) add(c Change) {
+	r.Changes = append(r.Changes, c)
+}
+
+func (r *Report) diffTable(tableName string, oldTable, newTable *proto.TableSchema) {
+	if newTable.Deprecated && !oldTable.Deprecated {
+		r.add(Change{Type: TableDeprecated, Table: tableName, New: newTable.ReplacedBy})
+	}
+	r.diffColumns(tableName, oldTable.Columns, newTable.Columns)
+	r.diffKeyColumns(tableName, "get", oldTable.GetCallKeyColumnList, newTable.GetCallKeyColumnList)
+	r.diffKeyColumns(tableName, "list", oldTable.ListCallKeyColumnList, newTable.ListCallKeyColumnList)
+}
+
+func (r *Report) diffColumns(tableName string, oldColumns, newColumns []*proto.ColumnDefinition) {
+	newColumnMap := make(map[string]*proto.ColumnDefinition, len(newColumns))
+	for _, c := range newColumns {
+		newColumnMap[c.Name] = c
+	}
+	oldColumnMap := make(map[string]*proto.ColumnDefinition, len(oldColumns))
+
+	for _, oldColumn := range oldColumns {
+		oldColumnMap[oldColumn.Name] = oldColumn
+		newColumn, ok := newColumnMap[oldColumn.Name]
+		if !ok {
+			r.add(Change{Type: ColumnRemoved, Table: tableName, Column: oldColumn.Name, Breaking: true})
+			continue
+		}
+		if newColumn.Type != oldColumn.Type {
+			r.add(Change{Type: ColumnTypeChanged, Table: tableName, Column: oldColumn.Name, Old: oldColumn.Type.String(), New: newColumn.Type.String(), Breaking: true})
+		}
+		if newColumn.Hydrate != oldColumn.Hydrate {
+			r.add(Change{Type: ColumnHydrateChanged, Table: tableName, Column: oldColumn.Name, Old: oldColumn.Hydrate, New: newColumn.Hydrate})
+		}
+		if newColumn.Deprecated && !oldColumn.Deprecated {
+			r.add(Change{Type: ColumnDeprecated, Table: tableName, Column: oldColumn.Name, New: newColumn.ReplacedBy})
+		}
+	}
+	for _, newColumn := range newColumns {
+		if _, ok := oldColumnMap[newColumn.Name]; !ok {
+			r.add(Change{Type: ColumnAdded, Table: tableName, Column: newColumn.Name})
+		}
+	}
+}
+
+// keyColumnSummary is the combined requirement and operators of all key columns with the same name
+type keyColumnSummary struct {
+	require   string
+	operators []string
+}
+
+// summariseKeyColumns builds a map of key column summaries, keyed by column name
+// if there are multiple key columns for a column, the strictest requirement is used
+func summariseKeyColumns(keyColumns []*proto.KeyColumn) map[string]*keyColumnSummary {
+	res := make(map[string]*keyColumnSummary)
+	for _, k := range keyColumns {
+		require := k.Require
+		if require == "" {
+			require = requireRequired
+		}
+		summary, ok := res[k.Name]
+		if !ok {
+			summary = &keyColumnSummary{require: require}
+			res[k.Name] = summary
+		} else if requireStrictness(require) > requireStrictness(summary.require) {
+			summary.require = require
+		}
+		for _, op := range k.Operators {
+			if !slices.Contains(summary.operators, op) {
+				summary.operators = append(summary.operators, op)
+			}
+		}
+	}
+	for _, summary := range res {
+		slices.Sort(summary.operators)
+	}
+	return res
+}
+
+func requireStrictness(require string) int {
+	switch require {
+	case requireRequired:
+		return 2
+	case requireAnyOf:
+		return 1
+	}
+	return 0
+}
+
+func (r *Report) diffKeyColumns(tableName, call string, oldKeyColumns, newKeyColumns []*proto.KeyColumn) {
+	oldSummaries := summariseKeyColumns(oldKeyColumns)
+	newSummaries := summariseKeyColumns(newKeyColumns)
+
+	names := maps.Keys(oldSummaries)
+	for name := range newSummaries {
+		if _, ok := oldSummaries[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		oldSummary, inOld := oldSummaries[name]
+		newSummary, inNew := newSummaries[name]
+		switch {
+		case !inOld:
+			// a new required key column means existing queries without a qual for this column will fail
+			// (new any_of key columns are checked below)
+			r.add(Change{Type: KeyColumnAdded, Table: tableName, Column: name, Call: call, New: newSummary.require, Breaking: newSummary.require == requireRequired})
+		case !inNew:
+			// removing a key column relaxes the requirements, so is not breaking
+			r.add(Change{Type: KeyColumnRemoved, Table: tableName, Column: name, Call: call, Old: oldSummary.require})
+		default:
+			if oldSummary.require != newSummary.require {
+				r.add(Change{Type: KeyColumnRequireChanged, Table: tableName, Column: name, Call: call, Old: oldSummary.require, New: newSummary.require, Breaking: newSummary.require == requireRequired})
+			}
+			if !slices.Equal(oldSummary.operators, newSummary.operators) {
+				// if a required key column no longer supports an operator, queries using that operator will fail
+				removedOperator := slices.ContainsFunc(oldSummary.operators, func(op string) bool { return !slices.Contains(newSummary.operators, op) })
+				r.add(Change{
+					Type:     KeyColumnOperatorsChanged,
+					Table:    tableName,
+					Column:   name,
+					Call:     call,
+					Old:      strings.Join(oldSummary.operators, ", "),
+					New:      strings.Join(newSummary.operators, ", "),
+					Breaking: removedOperator && newSummary.require != requireOptional,
+				})
+			}
+		}
+	}
+
+	r.diffAnyOfKeyColumns(tableName, call, oldSummaries, newSummaries)
+}
+
+// diffAnyOfKeyColumns reports a change to the set of any_of key columns
+//
+// the change is breaking unless every query which satisfied the old requirements satisfies the new any_of requirement, i.e.
+//   - one of the new any_of columns was previously required, or
+//   - all of the old any_of columns are still any_of columns
+func (r *Report) diffAnyOfKeyColumns(tableName, call string, oldSummaries, newSummaries map[string]*keyColumnSummary) {
+	oldAnyOf := namesWithRequire(oldSummaries, requireAnyOf)
+	newAnyOf := namesWithRequire(newSummaries, requireAnyOf)
+	if slices.Equal(oldAnyOf, newAnyOf) {
+		return
+	}
+
+	breaking := len(newAnyOf) > 0
+	for _, name := range newAnyOf {
+		if old, ok := oldSummaries[name]; ok && old.require == requireRequired {
+			breaking = false
+		}
+	}
+	if len(oldAnyOf) > 0 && !slices.ContainsFunc(oldAnyOf, func(name string) bool { return !slices.Contains(newAnyOf, name) }) {
+		breaking = false
+	}
+
+	r.add(Change{
+		Type:     AnyOfKeyColumnsChanged,
+		Table:    tableName,
+		Call:     call,
+		Old:      strings.Join(oldAnyOf, ", "),
+		New:      strings.Join(newAnyOf, ", "),
+		Breaking: breaking,
+	})
+}
+
+func namesWithRequire(summaries map[string]*keyColumnSummary, require string) []string {
+	var res []string
+	for name, summary := range summaries {
+		if summary.require == require {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+func pluralize(s string, count int) string {
+	if count == 1 {
+		return s
+	}
+	return s + "s"
+}
@@ -0,0 +1,225 @@
+package schema_diff
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func testSchema(tables map[string]*proto.TableSchema) *proto.Schema {
+	return &proto.Schema{Schema: tables, Mode: "static"}
+}
+
+func testTable(columns []*proto.ColumnDefinition, list ...*proto.KeyColumn) *proto.TableSchema {
+	return &proto.TableSchema{Columns: columns, ListCallKeyColumnList: list}
+}
+
+func column(name string, columnType proto.ColumnType, hydrate string) *proto.ColumnDefinition {
+	return &proto.ColumnDefinition{Name: name, Type: columnType, Hydrate: hydrate}
+}
+
+func keyColumn(name, require string, operators ...string) *proto.KeyColumn {
+	if len(operators) == 0 {
+		operators = []string{"="}
+	}
+	return &proto.KeyColumn{Name: name, Require: require, Operators: operators}
+}
+
+var baseColumns = []*proto.ColumnDefinition{
+	column("id", proto.ColumnType_STRING, "listThings"),
+	column("name", proto.ColumnType_STRING, "listThings"),
+	column("tags", proto.ColumnType_JSON, "getThing"),
+}
+
+type diffTest struct {
+	name     string
+	old      *proto.Schema
+	new      *proto.Schema
+	expected []string
+}
+
+var diffTests = []diffTest{
+	{
+		name:     "no changes",
+		old:      testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns)}),
+		new:      testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns)}),
+		expected: nil,
+	},
+	{
+		name: "tables added and removed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns), "old_thing": testTable(baseColumns)}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns), "new_thing": testTable(baseColumns)}),
+		expected: []string{
+			"table 'new_thing' was added",
+			"BREAKING table 'old_thing' was removed",
+		},
+	},
+	{
+		name: "columns added, removed and changed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns)}),
+		new: testSchema(map[string]*proto.TableSchema{"thing": testTable([]*proto.ColumnDefinition{
+			column("id", proto.ColumnType_INT, "listThings"),
+			column("tags", proto.ColumnType_JSON, "getThingTags"),
+			column("title", proto.ColumnType_STRING, "listThings"),
+		})}),
+		expected: []string{
+			"BREAKING table 'thing' column 'id' type changed from STRING to INT",
+			"BREAKING table 'thing' column 'name' was removed",
+			"table 'thing' column 'tags' hydrate function changed from 'getThing' to 'getThingTags'",
+			"table 'thing' column 'title' was added",
+		},
+	},
+	{
+		name: "column deprecated",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns)}),
+		new: testSchema(map[string]*proto.TableSchema{"thing": testTable([]*proto.ColumnDefinition{
+			column("id", proto.ColumnType_STRING, "listThings"),
+			{Name: "name", Type: proto.ColumnType_STRING, Hydrate: "listThings", Deprecated: true, ReplacedBy: "title"},
+			column("tags", proto.ColumnType_JSON, "getThing"),
+			column("title", proto.ColumnType_STRING, "listThings"),
+		})}),
+		expected: []string{
+			"table 'thing' column 'name' was deprecated",
+			"table 'thing' column 'title' was added",
+		},
+	},
+	{
+		name: "required key column added",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns)}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required"), keyColumn("name", "optional"))}),
+		expected: []string{
+			"BREAKING table 'thing' list key column 'id' was added (required)",
+			"table 'thing' list key column 'name' was added (optional)",
+		},
+	},
+	{
+		name: "key column require tightened",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "optional"))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required"))}),
+		expected: []string{
+			"BREAKING table 'thing' list key column 'id' require changed from 'optional' to 'required'",
+		},
+	},
+	{
+		name: "key column require relaxed and removed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required"), keyColumn("name", "required"))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "optional"))}),
+		expected: []string{
+			"table 'thing' list key column 'id' require changed from 'required' to 'optional'",
+			"table 'thing' list key column 'name' was removed",
+		},
+	},
+	{
+		name: "key column operators changed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required", "=", "<>"), keyColumn("name", "optional", "="))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required", "="), keyColumn("name", "optional", "=", "like"))}),
+		expected: []string{
+			"BREAKING table 'thing' list key column 'id' operators changed from [<>, =] to [=]",
+			"table 'thing' list key column 'name' operators changed from [=] to [=, like]",
+		},
+	},
+	{
+		name: "required key column becomes any_of",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "required"))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "any_of"), keyColumn("name", "any_of"))}),
+		expected: []string{
+			"table 'thing' list key column 'id' require changed from 'required' to 'any_of'",
+			"table 'thing' list key column 'name' was added (any_of)",
+			"table 'thing' list any_of key columns changed from [] to [id, name]",
+		},
+	},
+	{
+		name: "any_of key columns narrowed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "any_of"), keyColumn("name", "any_of"))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "any_of"))}),
+		expected: []string{
+			"table 'thing' list key column 'name' was removed",
+			"BREAKING table 'thing' list any_of key columns changed from [id, name] to [id]",
+		},
+	},
+	{
+		name: "any_of key columns widened",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "any_of"))}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns, keyColumn("id", "any_of"), keyColumn("name", "any_of"))}),
+		expected: []string{
+			"table 'thing' list key column 'name' was added (any_of)",
+			"table 'thing' list any_of key columns changed from [id] to [id, name]",
+		},
+	},
+	{
+		name: "get key column changed",
+		old:  testSchema(map[string]*proto.TableSchema{"thing": {Columns: baseColumns, GetCallKeyColumnList: []*proto.KeyColumn{keyColumn("id", "required")}}}),
+		new:  testSchema(map[string]*proto.TableSchema{"thing": {Columns: baseColumns, GetCallKeyColumnList: []*proto.KeyColumn{keyColumn("name", "required")}}}),
+		expected: []string{
+			"table 'thing' get key column 'id' was removed",
+			"BREAKING table 'thing' get key column 'name' was added (required)",
+		},
+	},
+}
+
+func TestDiff(t *testing.T) {
+	for _, test := range diffTests {
+		report := Diff(test.old, test.new)
+		var actual []string
+		for _, c := range report.Changes {
+			s := c.String()
+			if c.Breaking {
+				s = "BREAKING " + s
+			}
+			actual = append(actual, s)
+		}
+		if !slices.Equal(actual, test.expected) {
+			t.Errorf("test %s FAILED\nexpected:\n%s\ngot:\n%s", test.name, strings.Join(test.expected, "\n"), strings.Join(actual, "\n"))
+		}
+		expectBreaking := slices.ContainsFunc(test.expected, func(s string) bool { return strings.HasPrefix(s, "BREAKING ") })
+		if report.HasBreakingChanges() != expectBreaking {
+			t.Errorf("test %s FAILED: expected HasBreakingChanges %v", test.name, expectBreaking)
+		}
+		if (report.Err() != nil) != expectBreaking {
+			t.Errorf("test %s FAILED: expected Err to be non-nil %v, got %v", test.name, expectBreaking, report.Err())
+		}
+	}
+}
+
+func TestReportErr(t *testing.T) {
+	old := testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns), "other": testTable(baseColumns)})
+	new := testSchema(map[string]*proto.TableSchema{"thing": testTable(baseColumns[:2])})
+
+	err := Diff(old, new).Err()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	expected := `schema has 2 breaking changes:
+  - table 'other' was removed
+  - table 'thing' column 'tags' was removed`
+	if err.Error() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, err.Error())
+	}
+}
+
+func TestWriteAndParseSchema(t *testing.T) {
+	schema := testSchema(map[string]*proto.TableSchema{
+		"thing": testTable(baseColumns, keyColumn("id", "required")),
+	})
+	schema.SdkVersion = "5.11.0"
+	schema.ProtocolVersion = 20220201
+
+	var buf bytes.Buffer
+	if err := WriteSchema(&buf, schema); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseSchema(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !protobuf.Equal(schema, parsed) {
+		t.Errorf("parsed schema does not match:\n%s", buf.String())
+	}
+	if Diff(schema, parsed).Changes != nil {
+		t.Errorf("expected no changes")
+	}
+}
@@ -0,0 +1,41 @@
+package schema_diff
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WriteSchema writes the plugin schema as JSON
+func WriteSchema(w io.Writer, schema *proto.Schema) error {
+	opts := protojson.MarshalOptions{Multiline: true, Indent: "  "}
+	data, err := opts.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %s", err.Error())
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReadSchema reads a plugin schema from a JSON file written by WriteSchema
+func ReadSchema(path string) (*proto.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchema(data)
+}
+
+// ParseSchema parses a plugin schema from JSON written by WriteSchema
+func ParseSchema(data []byte) (*proto.Schema, error) {
+	schema := &proto.Schema{}
+	if err := protojson.Unmarshal(data, schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %s", err.Error())
+	}
+	return schema, nil
+}